@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN totp_step BIGINT NOT NULL DEFAULT 0;
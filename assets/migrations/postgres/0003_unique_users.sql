@@ -0,0 +1,4 @@
+DROP INDEX users_username_idx;
+DROP INDEX users_mail_idx;
+CREATE UNIQUE INDEX users_username_idx ON users (username) WHERE username <> '';
+CREATE UNIQUE INDEX users_mail_idx ON users (mail) WHERE mail <> '';
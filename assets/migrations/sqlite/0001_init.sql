@@ -0,0 +1,31 @@
+CREATE TABLE users (
+    id TEXT PRIMARY KEY,
+    username TEXT,
+    name TEXT NOT NULL DEFAULT '',
+    mail TEXT NOT NULL DEFAULT '',
+    mail_verified BOOLEAN NOT NULL DEFAULT 0,
+    password TEXT NOT NULL DEFAULT '',
+    roles TEXT NOT NULL DEFAULT '[]',
+    totp_secret TEXT NOT NULL DEFAULT '',
+    webauthn_credentials TEXT NOT NULL DEFAULT '[]',
+    disabled BOOLEAN NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    lock_reason TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX users_username_idx ON users (username);
+CREATE INDEX users_mail_idx ON users (mail);
+
+CREATE TABLE login_throttles (
+    throttle_key TEXT PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure TIMESTAMP NOT NULL,
+    blocked_until TIMESTAMP NOT NULL
+);
+CREATE INDEX login_throttles_last_failure_idx ON login_throttles (last_failure);
+
+CREATE TABLE password_resets (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    challenge TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL
+);
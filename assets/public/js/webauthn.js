@@ -0,0 +1,142 @@
+// webauthn helpers for the login and the passkey enrollment page.
+(function () {
+    // convert a base64url encoded string to an array buffer.
+    function toBuffer(value) {
+        var base64 = value.replace(/-/g, '+').replace(/_/g, '/');
+        var binary = atob(base64);
+        var bytes = new Uint8Array(binary.length);
+        for (var i = 0; i < binary.length; i++) {
+            bytes[i] = binary.charCodeAt(i);
+        }
+        return bytes.buffer;
+    }
+
+    // convert an array buffer to a base64url encoded string.
+    function toBase64URL(buffer) {
+        var bytes = new Uint8Array(buffer);
+        var binary = '';
+        for (var i = 0; i < bytes.length; i++) {
+            binary += String.fromCharCode(bytes[i]);
+        }
+        return btoa(binary).replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '');
+    }
+
+    // post the given body as json and return the decoded response.
+    function post(url, body) {
+        return fetch(url, {
+            method: 'POST',
+            credentials: 'same-origin',
+            headers: {'Content-Type': 'application/json'},
+            body: JSON.stringify(body)
+        }).then(function (res) {
+            return res.json().then(function (data) {
+                if (!res.ok) {
+                    var err = new Error(data.error || 'Request failed.');
+                    err.redirectTo = data.redirect_to;
+                    throw err;
+                }
+                return data;
+            });
+        });
+    }
+
+    // show the given message in the element with the given id.
+    function show(id, message) {
+        var el = document.getElementById(id);
+        el.querySelector('p').textContent = message;
+        el.style.display = 'block';
+    }
+
+    function login(button) {
+        var challenge = button.getAttribute('data-challenge');
+        var session;
+        post('/login/webauthn/begin', {challenge: challenge}).then(function (data) {
+            session = data.session;
+            var options = data.options.publicKey;
+            options.challenge = toBuffer(options.challenge);
+            (options.allowCredentials || []).forEach(function (c) {
+                c.id = toBuffer(c.id);
+            });
+            return navigator.credentials.get({publicKey: options});
+        }).then(function (credential) {
+            return post('/login/webauthn/finish', {
+                challenge: challenge,
+                session: session,
+                credential: {
+                    id: credential.id,
+                    rawId: toBase64URL(credential.rawId),
+                    type: credential.type,
+                    response: {
+                        clientDataJSON: toBase64URL(credential.response.clientDataJSON),
+                        authenticatorData: toBase64URL(credential.response.authenticatorData),
+                        signature: toBase64URL(credential.response.signature),
+                        userHandle: credential.response.userHandle ? toBase64URL(credential.response.userHandle) : ''
+                    }
+                }
+            });
+        }).then(function (data) {
+            window.location = data.redirect_to;
+        }).catch(function (err) {
+            show('webauthn-alert', err.message);
+            // the login was rejected, only offer to return to the application
+            if (err.redirectTo) {
+                document.querySelectorAll('form, .passkey-container').forEach(function (el) {
+                    el.style.display = 'none';
+                });
+                var list = document.getElementById('webauthn-continue');
+                list.querySelector('a').href = err.redirectTo;
+                list.style.display = 'block';
+            }
+        });
+    }
+
+    function register(button) {
+        var token = button.getAttribute('data-token');
+        var session;
+        post('/webauthn/begin', {token: token}).then(function (data) {
+            session = data.session;
+            var options = data.options.publicKey;
+            options.challenge = toBuffer(options.challenge);
+            options.user.id = toBuffer(options.user.id);
+            (options.excludeCredentials || []).forEach(function (c) {
+                c.id = toBuffer(c.id);
+            });
+            return navigator.credentials.create({publicKey: options});
+        }).then(function (credential) {
+            return post('/webauthn/finish', {
+                token: token,
+                session: session,
+                credential: {
+                    id: credential.id,
+                    rawId: toBase64URL(credential.rawId),
+                    type: credential.type,
+                    response: {
+                        clientDataJSON: toBase64URL(credential.response.clientDataJSON),
+                        attestationObject: toBase64URL(credential.response.attestationObject),
+                        transports: credential.response.getTransports ? credential.response.getTransports() : []
+                    }
+                }
+            });
+        }).then(function () {
+            button.style.display = 'none';
+            show('webauthn-info', 'Your passkey has been registered.');
+        }).catch(function (err) {
+            show('webauthn-alert', err.message);
+        });
+    }
+
+    document.addEventListener('DOMContentLoaded', function () {
+        var loginButton = document.getElementById('passkey-login');
+        if (loginButton) {
+            loginButton.addEventListener('click', function () {
+                login(loginButton);
+            });
+        }
+        var registerButton = document.getElementById('passkey-register');
+        if (registerButton) {
+            registerButton.addEventListener('click', function () {
+                register(registerButton);
+            });
+        }
+    });
+})();
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rbicker/godra/internal/db"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// importRecord represents a user to import.
+// Either a plaintext password, which gets hashed,
+// or an existing bcrypt hash can be given.
+type importRecord struct {
+	Username     string   `json:"username"`
+	Name         string   `json:"name"`
+	Mail         string   `json:"mail"`
+	MailVerified bool     `json:"mail_verified"`
+	Password     string   `json:"password"`
+	PasswordHash string   `json:"password_hash"`
+	Roles        []string `json:"roles"`
+	Disabled     bool     `json:"disabled"`
+}
+
+// importUsers creates the users from a csv or json file.
+// Users whose username or mail address already exists are skipped.
+func importUsers(ctx context.Context, con db.Database, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "file format, csv or json (default: derived from the file extension)")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected exactly one file")
+	}
+	path := fs.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open file: %w", err)
+	}
+	defer f.Close()
+	var records []importRecord
+	switch *format {
+	case "csv":
+		records, err = readCSV(f)
+	case "json":
+		err = json.NewDecoder(f).Decode(&records)
+	default:
+		return fmt.Errorf("unknown format '%s', expected csv or json", *format)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read %s file: %w", *format, err)
+	}
+	created, skipped, failed := importRecords(ctx, con, records)
+	fmt.Printf("created %v, skipped %v, failed %v users\n", created, skipped, failed)
+	if failed > 0 {
+		return fmt.Errorf("%v users could not be imported", failed)
+	}
+	return nil
+}
+
+// importRecords creates the users from the given records and returns
+// how many were created, skipped as they already exist and failed.
+// The reason for skipping or failing is printed for each record.
+func importRecords(ctx context.Context, con db.Database, records []importRecord) (created int, skipped int, failed int) {
+	for i, rec := range records {
+		u, err := newImportUser(rec)
+		if err == nil {
+			err = db.CheckUnique(ctx, con, primitive.NilObjectID, u.Username, u.Mail)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "record %v: skipped, %v\n", i+1, err)
+				skipped++
+				continue
+			}
+			err = con.CreateUser(ctx, u)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "record %v: %v\n", i+1, err)
+			failed++
+			continue
+		}
+		created++
+	}
+	return created, skipped, failed
+}
+
+// newImportUser validates the given record and creates the user from it.
+func newImportUser(rec importRecord) (*db.User, error) {
+	if rec.Username == "" && rec.Mail == "" {
+		return nil, fmt.Errorf("username or mail needs to be set")
+	}
+	u := &db.User{
+		Username:     rec.Username,
+		Name:         rec.Name,
+		Mail:         rec.Mail,
+		MailVerified: rec.MailVerified,
+		Password:     rec.PasswordHash,
+		Roles:        rec.Roles,
+		Disabled:     rec.Disabled,
+	}
+	if u.Roles == nil {
+		u.Roles = []string{}
+	}
+	switch {
+	case rec.Password != "" && rec.PasswordHash != "":
+		return nil, fmt.Errorf("password and password_hash are both set")
+	case rec.Password != "":
+		if _, err := validatePassword(rec.Password); err != nil {
+			return nil, err
+		}
+		hash, err := db.HashPassword(rec.Password)
+		if err != nil {
+			return nil, fmt.Errorf("unable to hash password: %w", err)
+		}
+		u.Password = hash
+	case rec.PasswordHash != "":
+		if _, err := bcrypt.Cost([]byte(rec.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("invalid password_hash: %w", err)
+		}
+	}
+	return u, nil
+}
+
+// readCSV reads the records from a csv file. The first line needs
+// to contain the column names, which are the same as the json field names.
+// Roles are separated by semicolons.
+func readCSV(r io.Reader) ([]importRecord, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var records []importRecord
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var rec importRecord
+		for i, col := range header {
+			v := strings.TrimSpace(row[i])
+			switch strings.ToLower(strings.TrimSpace(col)) {
+			case "username":
+				rec.Username = v
+			case "name":
+				rec.Name = v
+			case "mail":
+				rec.Mail = v
+			case "mail_verified":
+				if v == "" {
+					continue
+				}
+				if rec.MailVerified, err = strconv.ParseBool(v); err != nil {
+					return nil, fmt.Errorf("invalid value '%s' for mail_verified", v)
+				}
+			case "password":
+				rec.Password = v
+			case "password_hash":
+				rec.PasswordHash = v
+			case "roles":
+				rec.Roles = []string{}
+				for _, role := range strings.Split(v, ";") {
+					if role = strings.TrimSpace(role); role != "" {
+						rec.Roles = append(rec.Roles, role)
+					}
+				}
+			case "disabled":
+				if v == "" {
+					continue
+				}
+				if rec.Disabled, err = strconv.ParseBool(v); err != nil {
+					return nil, fmt.Errorf("invalid value '%s' for disabled", v)
+				}
+			default:
+				return nil, fmt.Errorf("unknown column '%s'", col)
+			}
+		}
+		records = append(records, rec)
+	}
+}
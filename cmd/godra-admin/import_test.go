@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rbicker/godra/internal/db"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		want    []importRecord
+		wantErr bool
+	}{
+		{
+			name: "columns",
+			csv: `Username, name, mail, mail_verified, password, password_hash, roles, disabled
+alice, Alice, alice@example.com, true, secret123, , admin, false`,
+			want: []importRecord{{
+				Username:     "alice",
+				Name:         "Alice",
+				Mail:         "alice@example.com",
+				MailVerified: true,
+				Password:     "secret123",
+				Roles:        []string{"admin"},
+			}},
+		},
+		{
+			name: "columns in any order",
+			csv: `mail,username
+bob@example.com,bob`,
+			want: []importRecord{{Username: "bob", Mail: "bob@example.com"}},
+		},
+		{
+			name: "roles",
+			csv: `username,roles
+alice,admin; editor;;viewer
+bob,`,
+			want: []importRecord{
+				{Username: "alice", Roles: []string{"admin", "editor", "viewer"}},
+				{Username: "bob", Roles: []string{}},
+			},
+		},
+		{
+			name: "empty flags",
+			csv: `username,mail_verified,disabled
+alice,,`,
+			want: []importRecord{{Username: "alice"}},
+		},
+		{
+			name: "invalid flag",
+			csv: `username,disabled
+alice,maybe`,
+			wantErr: true,
+		},
+		{
+			name: "unknown column",
+			csv: `username,group
+alice,admin`,
+			wantErr: true,
+		},
+		{
+			name: "missing column",
+			csv: `username,mail
+alice`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readCSV(strings.NewReader(tt.csv))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewImportUser(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		rec     importRecord
+		wantErr string
+	}{
+		{name: "password", rec: importRecord{Username: "alice", Password: "secret123"}},
+		{name: "password hash", rec: importRecord{Username: "alice", PasswordHash: string(hash)}},
+		{name: "without password", rec: importRecord{Mail: "alice@example.com"}},
+		{name: "without username and mail", rec: importRecord{Password: "secret123"}, wantErr: "username or mail"},
+		{name: "password and hash", rec: importRecord{Username: "alice", Password: "secret123", PasswordHash: string(hash)}, wantErr: "both set"},
+		{name: "short password", rec: importRecord{Username: "alice", Password: "secret"}, wantErr: "at least"},
+		{name: "invalid hash", rec: importRecord{Username: "alice", PasswordHash: "secret123"}, wantErr: "invalid password_hash"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := newImportUser(tt.rec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing '%s', got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.Roles == nil {
+				t.Fatalf("expected empty roles instead of nil")
+			}
+			password := tt.rec.Password
+			if password == "" && tt.rec.PasswordHash != "" {
+				password = "secret123"
+			}
+			if password != "" && u.ValidatePassword(password) != nil {
+				t.Fatalf("expected the password to be valid")
+			}
+		})
+	}
+}
+
+func TestImportRecords(t *testing.T) {
+	ctx := context.Background()
+	d, err := db.NewMemoryDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = d.CreateUser(ctx, &db.User{Username: "alice", Mail: "alice@example.com", Roles: []string{}}); err != nil {
+		t.Fatal(err)
+	}
+	records, err := readCSV(strings.NewReader(`username,mail,password,roles
+alice,,secret123,
+bob,alice@example.com,secret123,
+carol,carol@example.com,secret123,admin;editor
+dave,,short,
+erin,erin@example.com,,`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, skipped, failed := importRecords(ctx, d, records)
+	if created != 2 || skipped != 2 || failed != 1 {
+		t.Fatalf("expected 2 created, 2 skipped and 1 failed, got %v, %v and %v", created, skipped, failed)
+	}
+	carol, err := d.FindUserByUsernameOrMail(ctx, "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(carol.Roles, []string{"admin", "editor"}) || carol.ValidatePassword("secret123") != nil {
+		t.Fatalf("expected carol to be imported with roles and password, got %+v", carol)
+	}
+	// importing the same file again skips all users which were created
+	created, skipped, failed = importRecords(ctx, d, records)
+	if created != 0 || skipped != 4 || failed != 1 {
+		t.Fatalf("expected 0 created, 4 skipped and 1 failed, got %v, %v and %v", created, skipped, failed)
+	}
+}
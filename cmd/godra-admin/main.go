@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/rbicker/godra/internal/config"
+	"github.com/rbicker/godra/internal/db"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/term"
+)
+
+const usage = `godra-admin manages the users in godra's database.
+The database is configured like for godra-server, using the config file given by CONFIG_FILE
+and the environment variables, including their _FILE variants.
+
+usage:
+  godra-admin add -username <username> -mail <mail> [-name <name>] [-mail-verified] [-roles <role,...>] [-disabled]
+  godra-admin passwd <user>
+  godra-admin roles <user> <role,...>
+  godra-admin disable [-reason <reason>] <user>
+  godra-admin enable <user>
+  godra-admin list [-offset <n>] [-limit <n>]
+  godra-admin import [-format csv|json] <file>
+
+<user> is the id, username or mail address of a user.
+Passwords are read from the terminal without echo, or from stdin if it is not a terminal.
+`
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	commands := map[string]func(context.Context, db.Database, []string) error{
+		"add":     add,
+		"passwd":  passwd,
+		"roles":   roles,
+		"disable": disable,
+		"enable":  enable,
+		"list":    list,
+		"import":  importUsers,
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	cfg, err := config.Load(nil)
+	if err != nil {
+		log.Fatalf("invalid configuration:\n%v\n", err)
+	}
+	con, err := config.NewDatabase(cfg)
+	if err != nil {
+		log.Fatalf("%v\n", err)
+	}
+	ctx := context.Background()
+	if err = con.Connect(ctx); err != nil {
+		log.Fatalf("could not connect to database: %v\n", err)
+	}
+	err = cmd(ctx, con, os.Args[2:])
+	con.Disconnect(ctx)
+	if err != nil {
+		log.Fatalf("%s: %v\n", os.Args[1], err)
+	}
+}
+
+// findUser searches for the user with the given id, username or mail address.
+func findUser(ctx context.Context, con db.Database, s string) (*db.User, error) {
+	u, err := con.FindUserByID(ctx, s)
+	if !errors.Is(err, db.ErrUserNotFound) {
+		return u, err
+	}
+	return con.FindUserByUsernameOrMail(ctx, s)
+}
+
+// splitRoles splits the given comma separated list of roles.
+func splitRoles(s string) []string {
+	roles := []string{}
+	for _, r := range strings.Split(s, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			roles = append(roles, r)
+		}
+	}
+	return roles
+}
+
+// readPassword reads a new password. If stdin is a terminal,
+// the password is read without echo and needs to be confirmed.
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", fmt.Errorf("unable to read password: %w", err)
+		}
+		return validatePassword(strings.TrimRight(line, "\r\n"))
+	}
+	fmt.Fprint(os.Stderr, "New password: ")
+	b, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("unable to read password: %w", err)
+	}
+	fmt.Fprint(os.Stderr, "Confirm password: ")
+	confirm, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("unable to read password: %w", err)
+	}
+	if string(b) != string(confirm) {
+		return "", fmt.Errorf("the passwords do not match")
+	}
+	return validatePassword(string(b))
+}
+
+// validatePassword checks that the given password is long enough.
+func validatePassword(password string) (string, error) {
+	if len(password) < db.MinPasswordLength {
+		return "", fmt.Errorf("the password needs to be at least %v characters long", db.MinPasswordLength)
+	}
+	return password, nil
+}
+
+// add creates a new user.
+func add(ctx context.Context, con db.Database, args []string) error {
+	fs := flag.NewFlagSet("add", flag.ExitOnError)
+	username := fs.String("username", "", "username")
+	mail := fs.String("mail", "", "mail address")
+	name := fs.String("name", "", "full name")
+	mailVerified := fs.Bool("mail-verified", false, "mark the mail address as verified")
+	roleList := fs.String("roles", "", "comma separated list of roles")
+	disabled := fs.Bool("disabled", false, "create the user disabled")
+	fs.Parse(args)
+	if *username == "" && *mail == "" {
+		return fmt.Errorf("username or mail needs to be set")
+	}
+	if err := db.CheckUnique(ctx, con, primitive.NilObjectID, *username, *mail); err != nil {
+		return err
+	}
+	password, err := readPassword()
+	if err != nil {
+		return err
+	}
+	hash, err := db.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("unable to hash password: %w", err)
+	}
+	u := &db.User{
+		Username:     *username,
+		Name:         *name,
+		Mail:         *mail,
+		MailVerified: *mailVerified,
+		Password:     hash,
+		Roles:        splitRoles(*roleList),
+		Disabled:     *disabled,
+	}
+	if err = con.CreateUser(ctx, u); err != nil {
+		return fmt.Errorf("unable to create user: %w", err)
+	}
+	fmt.Printf("created user %s\n", u.Subject())
+	return nil
+}
+
+// passwd sets the password of a user.
+func passwd(ctx context.Context, con db.Database, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one user")
+	}
+	u, err := findUser(ctx, con, args[0])
+	if err != nil {
+		return err
+	}
+	password, err := readPassword()
+	if err != nil {
+		return err
+	}
+	hash, err := db.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("unable to hash password: %w", err)
+	}
+	if err = con.UpdatePassword(ctx, u.Subject(), hash); err != nil {
+		return fmt.Errorf("unable to update password: %w", err)
+	}
+	fmt.Printf("updated password of user %s\n", u.Subject())
+	return nil
+}
+
+// roles replaces the roles of a user.
+func roles(ctx context.Context, con db.Database, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected a user and a comma separated list of roles")
+	}
+	u, err := findUser(ctx, con, args[0])
+	if err != nil {
+		return err
+	}
+	if err = con.UpdateRoles(ctx, u.Subject(), splitRoles(args[1])); err != nil {
+		return fmt.Errorf("unable to update roles: %w", err)
+	}
+	fmt.Printf("updated roles of user %s\n", u.Subject())
+	return nil
+}
+
+// disable disables a user.
+func disable(ctx context.Context, con db.Database, args []string) error {
+	fs := flag.NewFlagSet("disable", flag.ExitOnError)
+	reason := fs.String("reason", "", "reason shown to the user")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected exactly one user")
+	}
+	u, err := findUser(ctx, con, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err = con.UpdateDisabled(ctx, u.Subject(), true, *reason); err != nil {
+		return fmt.Errorf("unable to disable user: %w", err)
+	}
+	fmt.Printf("disabled user %s\n", u.Subject())
+	return nil
+}
+
+// enable enables a disabled user.
+func enable(ctx context.Context, con db.Database, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one user")
+	}
+	u, err := findUser(ctx, con, args[0])
+	if err != nil {
+		return err
+	}
+	if err = con.UpdateDisabled(ctx, u.Subject(), false, ""); err != nil {
+		return fmt.Errorf("unable to enable user: %w", err)
+	}
+	fmt.Printf("enabled user %s\n", u.Subject())
+	return nil
+}
+
+// list prints the users as a table.
+func list(ctx context.Context, con db.Database, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	offset := fs.Int("offset", 0, "number of users to skip")
+	limit := fs.Int("limit", 1000, "maximum number of users to list")
+	fs.Parse(args)
+	users, err := con.FindUsers(ctx, *offset, *limit)
+	if err != nil {
+		return fmt.Errorf("unable to list users: %w", err)
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tUSERNAME\tNAME\tMAIL\tROLES\tDISABLED\tLOCKED\tTOTP\tPASSKEYS")
+	for i := range users {
+		u := &users[i]
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%v\t%v\t%v\t%v\n",
+			u.Subject(), u.Username, u.Name, u.Mail, strings.Join(u.Roles, ","),
+			u.Disabled, u.Locked(), u.TOTPEnabled(), len(u.WebAuthnCredentials))
+	}
+	return tw.Flush()
+}
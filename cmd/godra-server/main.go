@@ -1,60 +1,290 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
-
-	"github.com/rbicker/godra/internal/hydraclient"
+	"syscall"
 
+	"github.com/rbicker/godra/internal/config"
 	"github.com/rbicker/godra/internal/db"
 	"github.com/rbicker/godra/internal/godra"
+	"github.com/rbicker/godra/internal/hydraclient"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/mail"
+	"github.com/rbicker/godra/internal/metrics"
+	"github.com/rbicker/godra/internal/throttle"
 	"github.com/rbicker/godra/internal/utils"
 )
 
 func main() {
-	var dbOpts []func(*db.MGO) error
-	dbOpts = append(dbOpts, db.SetURL(utils.LoadSetting("MONGO_URL", "mongodb://localhost:27017")))
-	dbOpts = append(dbOpts, db.SetDBName(utils.LoadSetting("MONGO_DB", "db")))
-	dbOpts = append(dbOpts, db.SetCollectionName(utils.LoadSetting("MONGO_COLLECTION", "users")))
-	con, err := db.NewMongoConnection(dbOpts...)
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
 	if err != nil {
-		log.Fatalf("error while creating mongodb connection: %v\n", err)
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", err)
+		os.Exit(1)
 	}
-	err = con.Connect()
+	logger, err := logging.New(os.Stderr, cfg.LogFormat, cfg.LogLevel)
 	if err != nil {
-		log.Fatalf("could not connect to mongodb: %v\n", err)
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
 	}
-	var srvOpts []func(*godra.Server) error
-	var client hydraclient.Client
-	client.SetHydraPrivateURL(utils.LoadSetting("HYDRA_PRIVATE_URL", "http://localhost:4445"))
-	srvOpts = append(srvOpts, godra.SetHydraClient(client))
-	port := utils.LoadSetting("PORT", "5000")
-	p, err := strconv.Atoi(port)
+	slog.SetDefault(logger)
+	con, err := config.NewDatabase(cfg)
 	if err != nil {
-		log.Fatalf("invalid port '%s' given, unable to convert to integer", port)
+		fatal("invalid configuration", "error", err)
+	}
+	srvOpts, err := serverOptions(cfg, con)
+	if err != nil {
+		fatal("invalid configuration", "error", err)
 	}
-	srvOpts = append(srvOpts, godra.SetPort(p))
-	log.Printf("connected to mongodb")
-	srvOpts = append(srvOpts, godra.SetDatabase(con))
 	srv, err := godra.NewServer(srvOpts...)
 	if err != nil {
-		log.Fatalf("error while creating new godra server: %v", err)
+		fatal("error while creating new godra server", "error", err)
+	}
+	if cfg.Check {
+		if err := cfg.Write(os.Stdout); err != nil {
+			fatal("error while printing configuration", "error", err)
+		}
+		return
+	}
+	ctx := context.Background()
+	if err := con.Connect(ctx); err != nil {
+		fatal("could not connect to database", "error", err)
 	}
+	slog.Info("connected to database")
 	go func() {
-		log.Printf("starting godra server on port %s\n", port)
-		if err := srv.Serve(); err != nil {
-			log.Printf("http server startup encountered an error: %v\n", err)
-			con.Disconnect()
+		slog.Info("starting godra server", "port", cfg.Port, "tls", cfg.TLSCertFile != "")
+		if err := srv.Serve(); err != nil && err != http.ErrServerClosed {
+			slog.Error("http server startup encountered an error", "error", err)
+			con.Disconnect(ctx)
 			os.Exit(1)
 		}
 	}()
-	// wait for control+c to exit
+	if cfg.HTTPRedirectPort != 0 {
+		go func() {
+			slog.Info("starting http to https redirect", "port", cfg.HTTPRedirectPort)
+			if err := srv.ServeRedirect(); err != nil && err != http.ErrServerClosed {
+				slog.Error("http redirect startup encountered an error", "error", err)
+				con.Disconnect(ctx)
+				os.Exit(1)
+			}
+		}()
+	}
+	if cfg.MetricsEnabled && cfg.MetricsPort != 0 {
+		go func() {
+			slog.Info("starting metrics server", "port", cfg.MetricsPort)
+			if err := srv.ServeMetrics(); err != nil && err != http.ErrServerClosed {
+				slog.Error("metrics server startup encountered an error", "error", err)
+				con.Disconnect(ctx)
+				os.Exit(1)
+			}
+		}()
+	}
+	if cfg.AdminToken != "" {
+		go func() {
+			slog.Info("starting godra admin api", "port", cfg.AdminPort)
+			if err := srv.ServeAdmin(); err != nil && err != http.ErrServerClosed {
+				slog.Error("admin api startup encountered an error", "error", err)
+				con.Disconnect(ctx)
+				os.Exit(1)
+			}
+		}()
+	}
+	// wait for control+c or a termination request to exit
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	// block until a signal is received
-	<-c
-	con.Disconnect()
-	//srv.Shutdown()
+	sig := <-c
+	slog.Info("received signal, shutting down", "signal", sig.String())
+	// a second signal exits immediately
+	signal.Reset(os.Interrupt, syscall.SIGTERM)
+	if err := srv.Shutdown(); err != nil {
+		slog.Error("error while shutting down", "error", err)
+	}
+	if err := con.Disconnect(ctx); err != nil {
+		slog.Error("error while disconnecting from database", "error", err)
+	}
+	slog.Info("godra server stopped")
+}
+
+// serverOptions returns the options of the godra server
+// for the given configuration and database.
+// The files referenced by the configuration are read,
+// so they are validated before the server starts.
+func serverOptions(cfg *config.Config, con db.Database) ([]func(*godra.Server) error, error) {
+	client, err := newHydraClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	instrumented := metrics.InstrumentDatabase(con)
+	srvOpts := []func(*godra.Server) error{
+		godra.SetHydraClient(client),
+		godra.SetPort(cfg.Port),
+		godra.SetDatabase(instrumented),
+		godra.SetTOTPIssuer(cfg.TOTPIssuer),
+		godra.SetTrustedProxies(cfg.TrustedProxies),
+		godra.SetShutdownTimeout(cfg.ShutdownTimeout),
+		godra.SetShutdownDelay(cfg.ShutdownDelay),
+		godra.SetCustomization(godra.Customization{
+			StaticPath:     cfg.CustomStaticPath,
+			StylesheetPath: cfg.CustomStylesheetPath,
+			HeaderPath:     cfg.CustomHeaderPath,
+			FooterPath:     cfg.CustomFooterPath,
+		}),
+	}
+	if cfg.Secret != "" {
+		srvOpts = append(srvOpts, godra.SetSecret(cfg.Secret))
+	}
+	if cfg.TLSCertFile != "" {
+		srvOpts = append(srvOpts, godra.SetTLS(godra.TLSConfig{
+			CertFile:     cfg.TLSCertFile,
+			KeyFile:      cfg.TLSKeyFile,
+			MinVersion:   cfg.TLSMinVersion,
+			CipherSuites: cfg.TLSCipherSuites,
+			ClientCAFile: cfg.TLSClientCAFile,
+		}))
+	}
+	if cfg.HTTPRedirectPort != 0 {
+		srvOpts = append(srvOpts, godra.SetHTTPRedirect(cfg.HTTPRedirectPort))
+	}
+	if cfg.WebAuthnRPID != "" {
+		srvOpts = append(srvOpts, godra.SetWebAuthn(cfg.WebAuthnRPID, cfg.WebAuthnRPDisplayName, cfg.WebAuthnRPOrigins))
+	}
+	if cfg.ThrottleEnabled {
+		t, err := newThrottler(cfg, instrumented)
+		if err != nil {
+			return nil, err
+		}
+		srvOpts = append(srvOpts, godra.SetThrottler(t))
+	}
+	sender, err := newMailSender(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if db.IsReadOnly(con) && sender != nil {
+		slog.Info("password reset is not available for users from the ldap directory, ignoring MAIL_SENDER")
+		sender = nil
+	}
+	if sender != nil {
+		srvOpts = append(srvOpts, godra.SetMailSender(sender))
+		srvOpts = append(srvOpts, godra.SetPublicURL(cfg.PublicURL))
+		srvOpts = append(srvOpts, godra.SetPasswordResetTTL(cfg.PasswordResetTTL))
+	}
+	if cfg.ConsentMode == "prompt" {
+		srvOpts = append(srvOpts, godra.SetConsentPrompt(cfg.ConsentTrustedClients))
+	}
+	if cfg.ConsentScopesFile != "" {
+		var scopes map[string]godra.Scope
+		if err := utils.LoadConfigFile(cfg.ConsentScopesFile, &scopes); err != nil {
+			return nil, err
+		}
+		srvOpts = append(srvOpts, godra.SetScopes(scopes))
+	}
+	if cfg.ClaimsFile != "" {
+		var claims godra.ClaimsConfig
+		if err := utils.LoadConfigFile(cfg.ClaimsFile, &claims); err != nil {
+			return nil, err
+		}
+		srvOpts = append(srvOpts, godra.SetClaims(claims))
+	}
+	if cfg.PolicyFile != "" {
+		var policy godra.Policy
+		if err := utils.LoadConfigFile(cfg.PolicyFile, &policy); err != nil {
+			return nil, err
+		}
+		srvOpts = append(srvOpts, godra.SetPolicy(policy))
+	}
+	if cfg.AdminToken != "" {
+		srvOpts = append(srvOpts, godra.SetAdminAPI(cfg.AdminPort, cfg.AdminToken))
+	}
+	if cfg.MetricsEnabled {
+		srvOpts = append(srvOpts, godra.SetMetrics(cfg.MetricsPort))
+	}
+	return srvOpts, nil
+}
+
+// newHydraClient creates the client for hydra's
+// admin api using the given configuration.
+func newHydraClient(cfg *config.Config) (hydraclient.Client, error) {
+	opts := []func(*hydraclient.Client) error{
+		hydraclient.SetTimeout(cfg.HydraTimeout),
+		hydraclient.SetConnectionPool(cfg.HydraMaxIdleConns, cfg.HydraIdleConnTimeout),
+		hydraclient.SetForwardedProto(cfg.HydraForwardedProto),
+		hydraclient.SetRetries(cfg.HydraRetries, cfg.HydraRetryDelay),
+		hydraclient.SetCircuitBreaker(cfg.HydraBreakerThreshold, cfg.HydraBreakerCooldown),
+	}
+	if cfg.HydraCAFile != "" {
+		opts = append(opts, hydraclient.SetCAFile(cfg.HydraCAFile))
+	}
+	if cfg.HydraClientCertFile != "" {
+		opts = append(opts, hydraclient.SetClientCertificate(cfg.HydraClientCertFile, cfg.HydraClientKeyFile))
+	}
+	if cfg.HydraBearerToken != "" {
+		opts = append(opts, hydraclient.SetBearerToken(cfg.HydraBearerToken))
+	}
+	if cfg.HydraBasicAuthUser != "" {
+		opts = append(opts, hydraclient.SetBasicAuth(cfg.HydraBasicAuthUser, cfg.HydraBasicAuthPass))
+	}
+	return hydraclient.New(cfg.HydraPrivateURL, opts...)
+}
+
+// newThrottler creates the throttler for failed
+// login attempts using the given configuration.
+func newThrottler(cfg *config.Config, con db.Database) (*throttle.Throttler, error) {
+	t, err := throttle.New(
+		con,
+		throttle.SetFreeAttempts(cfg.ThrottleFreeAttempts),
+		throttle.SetDelay(cfg.ThrottleBaseDelay, cfg.ThrottleMaxDelay),
+		throttle.SetLockout(cfg.LockoutAttempts, cfg.LockoutDuration),
+		throttle.SetWindow(cfg.ThrottleWindow),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating login throttle: %w", err)
+	}
+	return t, nil
+}
+
+// newMailSender creates the sender for mails, such as the
+// password reset links, using the given configuration.
+// If no sender is configured, nil is returned.
+func newMailSender(cfg *config.Config) (mail.Sender, error) {
+	switch cfg.MailSender {
+	case "":
+		return nil, nil
+	case "smtp":
+		s, err := mail.NewSMTPSender(
+			mail.SetHost(cfg.SMTPHost, cfg.SMTPPort),
+			mail.SetCredentials(cfg.SMTPUsername, cfg.SMTPPassword),
+			mail.SetFrom(cfg.MailFrom),
+			mail.SetTimeout(cfg.SMTPTimeout),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating smtp mail sender: %w", err)
+		}
+		return s, nil
+	case "log":
+		return mail.NewLogSender(), nil
+	case "file":
+		s, err := mail.NewFileSender(cfg.MailFile)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating file mail sender: %w", err)
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("invalid mail sender '%s', expected smtp, log or file", cfg.MailSender)
+	}
+}
+
+// fatal logs the given message with the given attributes and exits.
+func fatal(msg string, args ...interface{}) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
@@ -0,0 +1,478 @@
+// Package config loads the configuration of the godra server.
+//
+// The settings are read from, in increasing order of precedence,
+// the defaults, a yaml, toml or json config file, the environment
+// variables and the command-line flags. Each setting is named after
+// its environment variable: the keys in the config file are the
+// lowercase names (mongo_url) and the flags are the lowercase
+// names using dashes (--mongo-url). Instead of the environment
+// variable itself, a variable with the suffix _FILE can point to a
+// file containing the value, such as a mounted secret.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// redacted replaces the values of secrets when printing the configuration.
+const redacted = "REDACTED"
+
+// Config is the configuration of the godra server.
+// The env tag names the setting, settings tagged
+// as secret are redacted when printed.
+type Config struct {
+	// File is the path of the config file, set using
+	// the --config flag or the CONFIG_FILE variable.
+	File string
+	// Check is set by the --check-config flag.
+	Check bool
+
+	Port                  int           `env:"PORT"`
+	HydraPrivateURL       string        `env:"HYDRA_PRIVATE_URL"`
+	HydraTimeout          time.Duration `env:"HYDRA_TIMEOUT"`
+	HydraMaxIdleConns     int           `env:"HYDRA_MAX_IDLE_CONNS"`
+	HydraIdleConnTimeout  time.Duration `env:"HYDRA_IDLE_CONN_TIMEOUT"`
+	HydraCAFile           string        `env:"HYDRA_CA_FILE"`
+	HydraClientCertFile   string        `env:"HYDRA_CLIENT_CERT_FILE"`
+	HydraClientKeyFile    string        `env:"HYDRA_CLIENT_KEY_FILE"`
+	HydraBearerToken      string        `env:"HYDRA_BEARER_TOKEN" secret:"true"`
+	HydraBasicAuthUser    string        `env:"HYDRA_BASIC_AUTH_USERNAME"`
+	HydraBasicAuthPass    string        `env:"HYDRA_BASIC_AUTH_PASSWORD" secret:"true"`
+	HydraForwardedProto   string        `env:"HYDRA_FORWARDED_PROTO"`
+	HydraRetries          int           `env:"HYDRA_RETRIES"`
+	HydraRetryDelay       time.Duration `env:"HYDRA_RETRY_DELAY"`
+	HydraBreakerThreshold int           `env:"HYDRA_BREAKER_THRESHOLD"`
+	HydraBreakerCooldown  time.Duration `env:"HYDRA_BREAKER_COOLDOWN"`
+	Secret                string        `env:"SECRET" secret:"true"`
+	TOTPIssuer            string        `env:"TOTP_ISSUER"`
+	WebAuthnRPID          string        `env:"WEBAUTHN_RP_ID"`
+	WebAuthnRPOrigins     []string      `env:"WEBAUTHN_RP_ORIGINS"`
+	WebAuthnRPDisplayName string        `env:"WEBAUTHN_RP_DISPLAY_NAME"`
+	TrustedProxies        []string      `env:"TRUSTED_PROXIES"`
+	TLSCertFile           string        `env:"TLS_CERT_FILE"`
+	TLSKeyFile            string        `env:"TLS_KEY_FILE"`
+	TLSMinVersion         string        `env:"TLS_MIN_VERSION"`
+	TLSCipherSuites       []string      `env:"TLS_CIPHER_SUITES"`
+	TLSClientCAFile       string        `env:"TLS_CLIENT_CA_FILE"`
+	HTTPRedirectPort      int           `env:"HTTP_REDIRECT_PORT"`
+	PublicURL             string        `env:"PUBLIC_URL"`
+	PasswordResetTTL      time.Duration `env:"PASSWORD_RESET_TTL"`
+	ConsentMode           string        `env:"CONSENT_MODE"`
+	ConsentTrustedClients []string      `env:"CONSENT_TRUSTED_CLIENTS"`
+	ConsentScopesFile     string        `env:"CONSENT_SCOPES_FILE"`
+	ClaimsFile            string        `env:"CLAIMS_FILE"`
+	PolicyFile            string        `env:"POLICY_FILE"`
+	AdminToken            string        `env:"ADMIN_TOKEN" secret:"true"`
+	AdminPort             int           `env:"ADMIN_PORT"`
+	MetricsEnabled        bool          `env:"METRICS_ENABLED"`
+	MetricsPort           int           `env:"METRICS_PORT"`
+	ShutdownTimeout       time.Duration `env:"SHUTDOWN_TIMEOUT"`
+	ShutdownDelay         time.Duration `env:"SHUTDOWN_DELAY"`
+	LogFormat             string        `env:"LOG_FORMAT"`
+	LogLevel              string        `env:"LOG_LEVEL"`
+	CustomStaticPath      string        `env:"CUSTOM_STATIC_PATH"`
+	CustomStylesheetPath  string        `env:"CUSTOM_STYLESHEET_PATH"`
+	CustomHeaderPath      string        `env:"CUSTOM_HEADER_PATH"`
+	CustomFooterPath      string        `env:"CUSTOM_FOOTER_PATH"`
+
+	DBDriver                string `env:"DB_DRIVER"`
+	DBDSN                   string `env:"DB_DSN" secret:"true"`
+	MongoURL                string `env:"MONGO_URL" secret:"true"`
+	MongoDB                 string `env:"MONGO_DB"`
+	MongoCollection         string `env:"MONGO_COLLECTION"`
+	MongoThrottleCollection string `env:"MONGO_THROTTLE_COLLECTION"`
+	MongoResetCollection    string `env:"MONGO_RESET_COLLECTION"`
+	MemorySeedFile          string `env:"MEMORY_SEED_FILE"`
+	LDAPURL                 string `env:"LDAP_URL"`
+	LDAPStartTLS            bool   `env:"LDAP_START_TLS"`
+	LDAPBindDN              string `env:"LDAP_BIND_DN"`
+	LDAPBindPassword        string `env:"LDAP_BIND_PASSWORD" secret:"true"`
+	LDAPUserBaseDN          string `env:"LDAP_USER_BASE_DN"`
+	LDAPUserFilter          string `env:"LDAP_USER_FILTER"`
+	LDAPGroupBaseDN         string `env:"LDAP_GROUP_BASE_DN"`
+	LDAPGroupFilter         string `env:"LDAP_GROUP_FILTER"`
+	LDAPIDAttribute         string `env:"LDAP_ID_ATTRIBUTE"`
+	LDAPUsernameAttribute   string `env:"LDAP_USERNAME_ATTRIBUTE"`
+	LDAPNameAttribute       string `env:"LDAP_NAME_ATTRIBUTE"`
+	LDAPMailAttribute       string `env:"LDAP_MAIL_ATTRIBUTE"`
+	LDAPGroupAttribute      string `env:"LDAP_GROUP_ATTRIBUTE"`
+	LDAPStoreDriver         string `env:"LDAP_STORE_DRIVER"`
+
+	ThrottleEnabled      bool          `env:"THROTTLE_ENABLED"`
+	ThrottleFreeAttempts int           `env:"THROTTLE_FREE_ATTEMPTS"`
+	ThrottleBaseDelay    time.Duration `env:"THROTTLE_BASE_DELAY"`
+	ThrottleMaxDelay     time.Duration `env:"THROTTLE_MAX_DELAY"`
+	LockoutAttempts      int           `env:"LOCKOUT_ATTEMPTS"`
+	LockoutDuration      time.Duration `env:"LOCKOUT_DURATION"`
+	ThrottleWindow       time.Duration `env:"THROTTLE_WINDOW"`
+
+	MailSender   string        `env:"MAIL_SENDER"`
+	MailFrom     string        `env:"MAIL_FROM"`
+	MailFile     string        `env:"MAIL_FILE"`
+	SMTPHost     string        `env:"SMTP_HOST"`
+	SMTPPort     int           `env:"SMTP_PORT"`
+	SMTPUsername string        `env:"SMTP_USERNAME"`
+	SMTPPassword string        `env:"SMTP_PASSWORD" secret:"true"`
+	SMTPTimeout  time.Duration `env:"SMTP_TIMEOUT"`
+}
+
+// Default returns the default configuration.
+func Default() Config {
+	return Config{
+		Port:                    5000,
+		HydraPrivateURL:         "http://localhost:4445",
+		HydraTimeout:            5 * time.Second,
+		HydraMaxIdleConns:       10,
+		HydraIdleConnTimeout:    90 * time.Second,
+		HydraForwardedProto:     "https",
+		HydraRetries:            2,
+		HydraRetryDelay:         100 * time.Millisecond,
+		HydraBreakerThreshold:   5,
+		HydraBreakerCooldown:    30 * time.Second,
+		TOTPIssuer:              "godra",
+		WebAuthnRPDisplayName:   "godra",
+		TLSMinVersion:           "1.2",
+		PublicURL:               "http://localhost:5000",
+		PasswordResetTTL:        time.Hour,
+		ConsentMode:             "auto",
+		AdminPort:               5001,
+		ShutdownTimeout:         30 * time.Second,
+		LogFormat:               "json",
+		LogLevel:                "info",
+		DBDriver:                "mongo",
+		MongoURL:                "mongodb://localhost:27017",
+		MongoDB:                 "db",
+		MongoCollection:         "users",
+		MongoThrottleCollection: "login_throttles",
+		MongoResetCollection:    "password_resets",
+		LDAPURL:                 "ldap://localhost:389",
+		LDAPUserFilter:          "(|(uid={username})(mail={username}))",
+		LDAPGroupFilter:         "(|(member={dn})(uniqueMember={dn}))",
+		LDAPStoreDriver:         "memory",
+		ThrottleEnabled:         true,
+		ThrottleFreeAttempts:    3,
+		ThrottleBaseDelay:       time.Second,
+		ThrottleMaxDelay:        5 * time.Minute,
+		LockoutAttempts:         10,
+		LockoutDuration:         30 * time.Minute,
+		ThrottleWindow:          time.Hour,
+		MailFrom:                "godra@localhost",
+		MailFile:                "mails.txt",
+		SMTPHost:                "localhost",
+		SMTPPort:                587,
+		SMTPTimeout:             10 * time.Second,
+	}
+}
+
+// setting is a single setting of the configuration.
+type setting struct {
+	env    string
+	secret bool
+	value  reflect.Value
+}
+
+// key returns the key of the setting in the config file.
+func (s setting) key() string {
+	return strings.ToLower(s.env)
+}
+
+// flag returns the name of the command-line flag of the setting.
+func (s setting) flag() string {
+	return strings.ReplaceAll(s.key(), "_", "-")
+}
+
+// set parses the given string and assigns it to the setting.
+// Lists are given as comma separated values.
+func (s setting) set(v string) error {
+	switch s.value.Interface().(type) {
+	case string:
+		s.value.SetString(v)
+	case int:
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("invalid integer '%s' given for %s", v, s.env)
+		}
+		s.value.SetInt(int64(i))
+	case bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("invalid boolean '%s' given for %s", v, s.env)
+		}
+		s.value.SetBool(b)
+	case time.Duration:
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("invalid duration '%s' given for %s", v, s.env)
+		}
+		s.value.SetInt(int64(d))
+	case []string:
+		var list []string
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		s.value.Set(reflect.ValueOf(list))
+	}
+	return nil
+}
+
+// settings returns the settings of the configuration in the order they are defined.
+func (c *Config) settings() []setting {
+	var settings []setting
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		env, ok := f.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		settings = append(settings, setting{env: env, secret: f.Tag.Get("secret") == "true", value: v.Field(i)})
+	}
+	return settings
+}
+
+// flagValue records the value of a command-line flag, which is
+// assigned to the setting after the config file has been read.
+type flagValue struct {
+	values map[string]string
+	name   string
+	isBool bool
+}
+
+func (f flagValue) String() string {
+	return ""
+}
+
+func (f flagValue) Set(v string) error {
+	f.values[f.name] = v
+	return nil
+}
+
+func (f flagValue) IsBoolFlag() bool {
+	return f.isBool
+}
+
+// Load builds the configuration from the defaults, the config file,
+// the environment variables and the given command-line arguments
+// and validates it. flag.ErrHelp is returned if help was requested.
+func Load(args []string) (*Config, error) {
+	c := Default()
+	settings := c.settings()
+	fs := flag.NewFlagSet("godra-server", flag.ContinueOnError)
+	fs.StringVar(&c.File, "config", os.Getenv("CONFIG_FILE"), "yaml, toml or json config file")
+	fs.BoolVar(&c.Check, "check-config", false, "validate and print the effective configuration, then exit")
+	flags := make(map[string]string)
+	for _, s := range settings {
+		_, isBool := s.value.Interface().(bool)
+		fs.Var(flagValue{values: flags, name: s.flag(), isBool: isBool}, s.flag(), fmt.Sprintf("overrides %s", s.env))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	var errs []error
+	if c.File != "" {
+		errs = append(errs, c.loadFile(settings))
+	}
+	errs = append(errs, c.loadEnv(settings))
+	for _, s := range settings {
+		if v, ok := flags[s.flag()]; ok {
+			errs = append(errs, s.set(v))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if c.WebAuthnRPID != "" && len(c.WebAuthnRPOrigins) == 0 {
+		c.WebAuthnRPOrigins = []string{fmt.Sprintf("https://%s", c.WebAuthnRPID)}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// loadFile reads the settings from the config file.
+// Unknown keys are reported as errors.
+func (c *Config) loadFile(settings []setting) error {
+	var values map[string]interface{}
+	if err := utils.LoadConfigFile(c.File, &values); err != nil {
+		return err
+	}
+	var errs []error
+	for _, s := range settings {
+		v, ok := values[s.key()]
+		if !ok {
+			continue
+		}
+		delete(values, s.key())
+		if err := s.set(fileValue(v)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid config file %s: %w", c.File, err))
+		}
+	}
+	for k := range values {
+		errs = append(errs, fmt.Errorf("unknown setting '%s' in config file %s", k, c.File))
+	}
+	return errors.Join(errs...)
+}
+
+// fileValue converts a value read from the config file to a string.
+func fileValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fileValue(item)
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// loadEnv reads the settings from the environment variables.
+// If a variable with the suffix _FILE is set instead, the value is
+// read from the file it points to, without trailing newlines.
+func (c *Config) loadEnv(settings []setting) error {
+	var errs []error
+	for _, s := range settings {
+		v, ok := os.LookupEnv(s.env)
+		path, fromFile := os.LookupEnv(s.env + "_FILE")
+		if ok && fromFile {
+			errs = append(errs, fmt.Errorf("both %s and %s_FILE are set", s.env, s.env))
+			continue
+		}
+		if fromFile {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("unable to read %s_FILE: %w", s.env, err))
+				continue
+			}
+			v, ok = strings.TrimRight(string(b), "\r\n"), true
+		}
+		if ok {
+			errs = append(errs, s.set(v))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate verifies the settings and returns all invalid values.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	validPort := func(p int) bool { return p > 0 && p <= 65535 }
+	check(validPort(c.Port), "invalid PORT %d, expected 1-65535", c.Port)
+	check(validPort(c.AdminPort), "invalid ADMIN_PORT %d, expected 1-65535", c.AdminPort)
+	check(c.MetricsPort == 0 || validPort(c.MetricsPort), "invalid METRICS_PORT %d, expected 0-65535", c.MetricsPort)
+	check(c.HTTPRedirectPort == 0 || validPort(c.HTTPRedirectPort), "invalid HTTP_REDIRECT_PORT %d, expected 0-65535", c.HTTPRedirectPort)
+	check(c.HTTPRedirectPort == 0 || c.TLSCertFile != "", "HTTP_REDIRECT_PORT requires TLS_CERT_FILE and TLS_KEY_FILE")
+	check((c.TLSCertFile == "") == (c.TLSKeyFile == ""), "TLS_CERT_FILE and TLS_KEY_FILE need to be given together")
+	check(c.TLSClientCAFile == "" || c.TLSCertFile != "", "TLS_CLIENT_CA_FILE requires TLS_CERT_FILE and TLS_KEY_FILE")
+	check(oneOf(c.TLSMinVersion, "1.0", "1.1", "1.2", "1.3"), "invalid TLS_MIN_VERSION '%s', expected 1.0, 1.1, 1.2 or 1.3", c.TLSMinVersion)
+	check(validPort(c.SMTPPort), "invalid SMTP_PORT %d, expected 1-65535", c.SMTPPort)
+	check(c.SMTPTimeout > 0, "invalid SMTP_TIMEOUT %v, expected a positive duration", c.SMTPTimeout)
+	check(validURL(c.HydraPrivateURL), "invalid HYDRA_PRIVATE_URL '%s', expected an absolute url", c.HydraPrivateURL)
+	check(c.HydraTimeout > 0, "invalid HYDRA_TIMEOUT %v, expected a positive duration", c.HydraTimeout)
+	check(c.HydraMaxIdleConns >= 0, "invalid HYDRA_MAX_IDLE_CONNS %d, expected at least 0", c.HydraMaxIdleConns)
+	check(c.HydraIdleConnTimeout >= 0, "invalid HYDRA_IDLE_CONN_TIMEOUT %v, expected a duration of at least 0s", c.HydraIdleConnTimeout)
+	check((c.HydraClientCertFile == "") == (c.HydraClientKeyFile == ""), "HYDRA_CLIENT_CERT_FILE and HYDRA_CLIENT_KEY_FILE need to be given together")
+	check(c.HydraBearerToken == "" || c.HydraBasicAuthUser == "", "HYDRA_BEARER_TOKEN and HYDRA_BASIC_AUTH_USERNAME cannot be used together")
+	check(c.HydraBasicAuthPass == "" || c.HydraBasicAuthUser != "", "HYDRA_BASIC_AUTH_PASSWORD requires HYDRA_BASIC_AUTH_USERNAME")
+	check(c.HydraRetries >= 0, "invalid HYDRA_RETRIES %d, expected at least 0", c.HydraRetries)
+	check(c.HydraRetryDelay >= 0, "invalid HYDRA_RETRY_DELAY %v, expected a duration of at least 0s", c.HydraRetryDelay)
+	check(c.HydraBreakerThreshold >= 0, "invalid HYDRA_BREAKER_THRESHOLD %d, expected at least 0", c.HydraBreakerThreshold)
+	check(c.HydraBreakerThreshold == 0 || c.HydraBreakerCooldown > 0, "invalid HYDRA_BREAKER_COOLDOWN %v, expected a positive duration", c.HydraBreakerCooldown)
+	check(validURL(c.PublicURL), "invalid PUBLIC_URL '%s', expected an absolute url", c.PublicURL)
+	check(c.Secret == "" || len(c.Secret) >= 32, "invalid SECRET, expected at least 32 characters")
+	check(oneOf(c.ConsentMode, "auto", "prompt"), "invalid CONSENT_MODE '%s', expected auto or prompt", c.ConsentMode)
+	check(oneOf(c.DBDriver, "mongo", "memory", "sqlite", "postgres", "ldap"), "invalid DB_DRIVER '%s', expected mongo, memory, sqlite, postgres or ldap", c.DBDriver)
+	check(c.DBDriver != "ldap" || c.LDAPUserBaseDN != "", "LDAP_USER_BASE_DN is required when using the ldap database")
+	check(oneOf(c.LDAPStoreDriver, "memory", "mongo", "sqlite", "postgres"), "invalid LDAP_STORE_DRIVER '%s', expected memory, mongo, sqlite or postgres", c.LDAPStoreDriver)
+	check(oneOf(c.MailSender, "", "smtp", "log", "file"), "invalid MAIL_SENDER '%s', expected smtp, log or file", c.MailSender)
+	check(oneOf(strings.ToLower(c.LogFormat), "json", "logfmt", "text"), "invalid LOG_FORMAT '%s', expected json, logfmt or text", c.LogFormat)
+	check(oneOf(strings.ToLower(c.LogLevel), "debug", "info", "warn", "error"), "invalid LOG_LEVEL '%s', expected debug, info, warn or error", c.LogLevel)
+	check(c.PasswordResetTTL > 0, "invalid PASSWORD_RESET_TTL %v, expected a positive duration", c.PasswordResetTTL)
+	check(c.ShutdownTimeout > 0, "invalid SHUTDOWN_TIMEOUT %v, expected a positive duration", c.ShutdownTimeout)
+	check(c.ShutdownDelay >= 0, "invalid SHUTDOWN_DELAY %v, expected a duration of at least 0s", c.ShutdownDelay)
+	check(c.ThrottleFreeAttempts >= 0, "invalid THROTTLE_FREE_ATTEMPTS %d, expected at least 0", c.ThrottleFreeAttempts)
+	check(c.ThrottleBaseDelay > 0, "invalid THROTTLE_BASE_DELAY %v, expected a positive duration", c.ThrottleBaseDelay)
+	check(c.ThrottleMaxDelay >= c.ThrottleBaseDelay, "invalid THROTTLE_MAX_DELAY %v, expected at least THROTTLE_BASE_DELAY", c.ThrottleMaxDelay)
+	check(c.LockoutAttempts >= 0, "invalid LOCKOUT_ATTEMPTS %d, expected at least 0", c.LockoutAttempts)
+	check(c.LockoutAttempts == 0 || c.LockoutDuration > 0, "invalid LOCKOUT_DURATION %v, expected a positive duration", c.LockoutDuration)
+	check(c.ThrottleWindow > 0 && c.ThrottleWindow <= db.LoginThrottleTTL, "invalid THROTTLE_WINDOW %v, expected a positive duration of at most %v", c.ThrottleWindow, db.LoginThrottleTTL)
+	return errors.Join(errs...)
+}
+
+// validURL returns true if the given string is an absolute url.
+func validURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// oneOf returns true if the given string is one of the given values.
+func oneOf(s string, values ...string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Write writes the configuration to w in the format of
+// a yaml config file. Secrets which are set are redacted.
+func (c *Config) Write(w io.Writer) error {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range c.settings() {
+		v := s.value.Interface()
+		switch {
+		case s.secret && s.value.String() != "":
+			v = redacted
+		case s.value.Type() == reflect.TypeOf(time.Duration(0)):
+			v = v.(time.Duration).String()
+		case s.value.Kind() == reflect.Slice && s.value.Len() == 0:
+			v = []string{}
+		}
+		var value yaml.Node
+		if err := value.Encode(v); err != nil {
+			return fmt.Errorf("unable to encode %s: %w", s.env, err)
+		}
+		if value.Kind == yaml.SequenceNode {
+			value.Style = yaml.FlowStyle
+		}
+		doc.Content = append(doc.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: s.key()}, &value)
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("unable to write configuration: %w", err)
+	}
+	return enc.Close()
+}
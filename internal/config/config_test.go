@@ -0,0 +1,306 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		wantErr string
+	}{
+		{name: "defaults", change: func(c *Config) {}},
+		{name: "text log format", change: func(c *Config) { c.LogFormat = "text" }},
+		{
+			name:    "invalid log format",
+			change:  func(c *Config) { c.LogFormat = "xml" },
+			wantErr: "invalid LOG_FORMAT 'xml', expected json, logfmt or text",
+		},
+		{name: "lockout disabled", change: func(c *Config) { c.LockoutAttempts = 0; c.LockoutDuration = 0 }},
+		{
+			name:    "negative lockout attempts",
+			change:  func(c *Config) { c.LockoutAttempts = -1 },
+			wantErr: "invalid LOCKOUT_ATTEMPTS -1",
+		},
+		{
+			name:    "lockout without duration",
+			change:  func(c *Config) { c.LockoutDuration = 0 },
+			wantErr: "invalid LOCKOUT_DURATION 0s",
+		},
+		{
+			name: "ldap store",
+			change: func(c *Config) {
+				c.DBDriver = "ldap"
+				c.LDAPUserBaseDN = "dc=example,dc=com"
+				c.LDAPStoreDriver = "postgres"
+			},
+		},
+		{
+			name:    "invalid ldap store",
+			change:  func(c *Config) { c.LDAPStoreDriver = "ldap" },
+			wantErr: "invalid LDAP_STORE_DRIVER 'ldap', expected memory, mongo, sqlite or postgres",
+		},
+		{
+			name:    "throttle window exceeding the retention",
+			change:  func(c *Config) { c.ThrottleWindow = 48 * time.Hour },
+			wantErr: "invalid THROTTLE_WINDOW 48h0m0s, expected a positive duration of at most 24h0m0s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			tt.change(&c)
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// writeFile writes the given content to a file
+// in a temporary directory and returns its path.
+func writeFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	yamlFile := "port: 6000\ndb_driver: sqlite\ntrusted_proxies: [10.0.0.0/8, 192.0.2.1]\nthrottle_window: 2h\nthrottle_enabled: false\n"
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		env     map[string]string
+		args    []string
+		want    func(c *Config) bool
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			want: func(c *Config) bool { return c.Port == 5000 && c.DBDriver == "mongo" },
+		},
+		{
+			name:    "yaml file",
+			file:    "godra.yaml",
+			content: yamlFile,
+			args:    []string{"--config", "{file}"},
+			want: func(c *Config) bool {
+				return c.Port == 6000 && c.DBDriver == "sqlite" && !c.ThrottleEnabled && c.ThrottleWindow == 2*time.Hour &&
+					reflect.DeepEqual(c.TrustedProxies, []string{"10.0.0.0/8", "192.0.2.1"})
+			},
+		},
+		{
+			name:    "toml file",
+			file:    "godra.toml",
+			content: "port = 6000\ndb_driver = \"sqlite\"\ntrusted_proxies = [\"10.0.0.0/8\"]\nthrottle_window = \"2h\"\nthrottle_enabled = false\n",
+			args:    []string{"--config", "{file}"},
+			want: func(c *Config) bool {
+				return c.Port == 6000 && c.DBDriver == "sqlite" && !c.ThrottleEnabled && c.ThrottleWindow == 2*time.Hour &&
+					reflect.DeepEqual(c.TrustedProxies, []string{"10.0.0.0/8"})
+			},
+		},
+		{
+			name:    "json file",
+			file:    "godra.json",
+			content: `{"port": 6000, "trusted_proxies": ["10.0.0.0/8"]}`,
+			args:    []string{"--config", "{file}"},
+			want: func(c *Config) bool {
+				return c.Port == 6000 && reflect.DeepEqual(c.TrustedProxies, []string{"10.0.0.0/8"})
+			},
+		},
+		{
+			name:    "environment overrides file",
+			file:    "godra.yaml",
+			content: yamlFile,
+			env:     map[string]string{"PORT": "7000", "TRUSTED_PROXIES": "172.16.0.0/12, 192.0.2.2"},
+			args:    []string{"--config", "{file}"},
+			want: func(c *Config) bool {
+				return c.Port == 7000 && c.DBDriver == "sqlite" && reflect.DeepEqual(c.TrustedProxies, []string{"172.16.0.0/12", "192.0.2.2"})
+			},
+		},
+		{
+			name:    "flag overrides environment",
+			file:    "godra.yaml",
+			content: yamlFile,
+			env:     map[string]string{"PORT": "7000", "DB_DRIVER": "memory"},
+			args:    []string{"--config", "{file}", "--port", "8000", "--throttle-enabled"},
+			want: func(c *Config) bool {
+				return c.Port == 8000 && c.DBDriver == "memory" && c.ThrottleEnabled
+			},
+		},
+		{
+			name:    "config file from the environment",
+			file:    "godra.yaml",
+			content: yamlFile,
+			env:     map[string]string{"CONFIG_FILE": "{file}"},
+			want:    func(c *Config) bool { return c.Port == 6000 },
+		},
+		{
+			name:    "value from file",
+			env:     map[string]string{"MONGO_URL_FILE": "{file}"},
+			file:    "mongo-url",
+			content: "mongodb://godra:secret@db:27017\n",
+			want:    func(c *Config) bool { return c.MongoURL == "mongodb://godra:secret@db:27017" },
+		},
+		{
+			name:    "value and file",
+			env:     map[string]string{"MONGO_URL": "mongodb://db:27017", "MONGO_URL_FILE": "{file}"},
+			file:    "mongo-url",
+			content: "mongodb://godra:secret@db:27017",
+			wantErr: "both MONGO_URL and MONGO_URL_FILE are set",
+		},
+		{
+			name:    "missing value file",
+			env:     map[string]string{"MONGO_URL_FILE": "/nonexistent/mongo-url"},
+			wantErr: "unable to read MONGO_URL_FILE",
+		},
+		{
+			name:    "unknown key in file",
+			file:    "godra.yaml",
+			content: "port: 6000\ncolour: blue\n",
+			args:    []string{"--config", "{file}"},
+			wantErr: "unknown setting 'colour' in config file",
+		},
+		{
+			name:    "invalid value in file",
+			file:    "godra.yaml",
+			content: "throttle_window: often\n",
+			args:    []string{"--config", "{file}"},
+			wantErr: "invalid duration 'often' given for THROTTLE_WINDOW",
+		},
+		{
+			name:    "invalid yaml",
+			file:    "godra.yaml",
+			content: "port: [6000\n",
+			args:    []string{"--config", "{file}"},
+			wantErr: "unable to parse config file",
+		},
+		{
+			name:    "invalid setting",
+			args:    []string{"--port", "0"},
+			wantErr: "invalid PORT 0",
+		},
+		{
+			name:    "unexpected argument",
+			args:    []string{"serve"},
+			wantErr: "unexpected arguments: serve",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// {file} is replaced with the path of the written file
+			var path string
+			if tt.file != "" {
+				path = writeFile(t, tt.file, tt.content)
+			}
+			var args []string
+			for _, arg := range tt.args {
+				args = append(args, strings.ReplaceAll(arg, "{file}", path))
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, strings.ReplaceAll(v, "{file}", path))
+			}
+			c, err := Load(args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !tt.want(c) {
+				t.Fatalf("unexpected configuration %+v", c)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name       string
+		change     func(c *Config)
+		contains   []string
+		notContain []string
+	}{
+		{
+			name:     "defaults",
+			change:   func(c *Config) {},
+			contains: []string{"port: 5000\n", "throttle_window: 1h0m0s\n", "trusted_proxies: []\n", "secret: \"\"\n", "throttle_enabled: true\n"},
+		},
+		{
+			name: "secrets",
+			change: func(c *Config) {
+				c.Secret = "0123456789abcdef0123456789abcdef"
+				c.MongoURL = "mongodb://godra:mongo-password@db:27017"
+				c.SMTPPassword = "smtp-password"
+			},
+			contains:   []string{"secret: REDACTED\n", "mongo_url: REDACTED\n", "smtp_password: REDACTED\n", "db_dsn: \"\"\n"},
+			notContain: []string{"0123456789abcdef", "mongo-password", "smtp-password"},
+		},
+		{
+			name:     "lists",
+			change:   func(c *Config) { c.TrustedProxies = []string{"10.0.0.0/8", "192.0.2.1"} },
+			contains: []string{"trusted_proxies: [10.0.0.0/8, 192.0.2.1]\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			tt.change(&c)
+			var buf bytes.Buffer
+			if err := c.Write(&buf); err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(buf.String(), s) {
+					t.Errorf("expected output to contain %q, got:\n%s", s, buf.String())
+				}
+			}
+			for _, s := range tt.notContain {
+				if strings.Contains(buf.String(), s) {
+					t.Errorf("expected output not to contain %q, got:\n%s", s, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestWriteCanBeLoaded(t *testing.T) {
+	c := Default()
+	c.Port = 6000
+	c.TrustedProxies = []string{"10.0.0.0/8"}
+	// secrets which are set cannot be loaded again, as they are redacted
+	c.MongoURL = ""
+	var buf bytes.Buffer
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load([]string{"--config", writeFile(t, "godra.yaml", buf.String())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded.File = ""
+	if !reflect.DeepEqual(*loaded, c) {
+		t.Fatalf("expected %+v, got %+v", c, *loaded)
+	}
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/rbicker/godra/internal/db"
+)
+
+// NewDatabase creates the database connection described by the
+// given configuration. It is shared by godra-server and godra-admin,
+// so both use the same database. Connect needs to be called before
+// using the returned database.
+func NewDatabase(cfg *Config) (db.Database, error) {
+	return newDatabase(cfg, cfg.DBDriver)
+}
+
+// newDatabase creates the database connection of the given driver.
+func newDatabase(cfg *Config, driver string) (db.Database, error) {
+	switch driver {
+	case "mongo":
+		con, err := db.NewMongoConnection(
+			db.SetURL(cfg.MongoURL),
+			db.SetDBName(cfg.MongoDB),
+			db.SetCollectionName(cfg.MongoCollection),
+			db.SetThrottleCollectionName(cfg.MongoThrottleCollection),
+			db.SetResetCollectionName(cfg.MongoResetCollection),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating mongodb connection: %w", err)
+		}
+		return con, nil
+	case "memory":
+		slog.Info("using the in-memory database - all changes are lost on restart")
+		var dbOpts []func(*db.Memory) error
+		if cfg.MemorySeedFile != "" {
+			dbOpts = append(dbOpts, db.SetSeedFile(cfg.MemorySeedFile))
+		}
+		con, err := db.NewMemoryDatabase(dbOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating in-memory database: %w", err)
+		}
+		return con, nil
+	case "sqlite", "postgres":
+		dbOpts := []func(*db.SQL) error{db.SetSQLDriver(driver)}
+		if cfg.DBDSN != "" {
+			dbOpts = append(dbOpts, db.SetDSN(cfg.DBDSN))
+		}
+		con, err := db.NewSQLConnection(dbOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating %s connection: %w", driver, err)
+		}
+		return con, nil
+	case "ldap":
+		// failed login attempts and password resets are
+		// kept in memory, unless another store is configured
+		var store db.Database
+		if cfg.LDAPStoreDriver == "memory" {
+			slog.Warn("failed login attempts are kept in memory, so they are lost on restart and not shared between replicas - set LDAP_STORE_DRIVER to keep them in a database")
+		} else {
+			var err error
+			if store, err = newDatabase(cfg, cfg.LDAPStoreDriver); err != nil {
+				return nil, err
+			}
+		}
+		ldapOpts := []func(*db.LDAP) error{
+			db.SetLDAPURL(cfg.LDAPURL),
+			db.SetLDAPStartTLS(cfg.LDAPStartTLS),
+			db.SetLDAPBind(cfg.LDAPBindDN, cfg.LDAPBindPassword),
+			db.SetLDAPUserSearch(cfg.LDAPUserBaseDN, cfg.LDAPUserFilter),
+			db.SetLDAPGroupSearch(cfg.LDAPGroupBaseDN, cfg.LDAPGroupFilter),
+			db.SetLDAPAttributes(db.LDAPAttributes{
+				ID:       cfg.LDAPIDAttribute,
+				Username: cfg.LDAPUsernameAttribute,
+				Name:     cfg.LDAPNameAttribute,
+				Mail:     cfg.LDAPMailAttribute,
+				Group:    cfg.LDAPGroupAttribute,
+			}),
+		}
+		if store != nil {
+			ldapOpts = append(ldapOpts, db.SetLDAPStore(store))
+		}
+		con, err := db.NewLDAPConnection(ldapOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating ldap connection: %w", err)
+		}
+		return con, nil
+	default:
+		return nil, fmt.Errorf("invalid database driver '%s', expected mongo, memory, sqlite, postgres or ldap", driver)
+	}
+}
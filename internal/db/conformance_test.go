@@ -0,0 +1,399 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// databases returns the implementations of the database interface
+// which are tested for conformance. MongoDB is only tested if
+// MONGO_URL is set, using a database which is dropped afterwards.
+func databases(t *testing.T) map[string]func(t *testing.T) Database {
+	t.Helper()
+	dbs := map[string]func(t *testing.T) Database{
+		"memory": func(t *testing.T) Database {
+			d, err := NewMemoryDatabase()
+			if err != nil {
+				t.Fatal(err)
+			}
+			return d
+		},
+		"sqlite": newSQLiteDatabase,
+	}
+	if url := os.Getenv("MONGO_URL"); url != "" {
+		dbs["mongo"] = func(t *testing.T) Database {
+			d, err := NewMongoConnection(SetURL(url), SetDBName("godra_test_"+primitive.NewObjectID().Hex()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = d.Connect(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				m := d.(*MGO)
+				m.client.Database(m.dbname).Drop(context.Background())
+				d.Disconnect(context.Background())
+			})
+			return d
+		}
+	}
+	return dbs
+}
+
+func TestDatabaseConformance(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, d Database)
+	}{
+		{name: "users", test: testUsers},
+		{name: "user not found", test: testUserNotFound},
+		{name: "unique users", test: testUniqueUsers},
+		{name: "totp step", test: testTOTPStep},
+		{name: "login throttles", test: testLoginThrottles},
+		{name: "password resets", test: testPasswordResets},
+	}
+	for name, newDatabase := range databases(t) {
+		t.Run(name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					tt.test(t, newDatabase(t))
+				})
+			}
+		})
+	}
+}
+
+func testUsers(t *testing.T, d Database) {
+	ctx := context.Background()
+	u := &User{Username: "alice", Mail: "alice@example.com", Roles: []string{"admin"}}
+	if err := d.CreateUser(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if u.ID.IsZero() {
+		t.Fatal("expected the id to be set")
+	}
+	for _, s := range []string{"alice", "alice@example.com"} {
+		found, err := d.FindUserByUsernameOrMail(ctx, s)
+		if err != nil {
+			t.Fatalf("searching %v: %v", s, err)
+		}
+		if found.Subject() != u.Subject() {
+			t.Fatalf("searching %v: expected user %v, got %v", s, u.Subject(), found.Subject())
+		}
+	}
+	u.Name = "Alice"
+	if err := d.UpdateUser(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.UpdateRoles(ctx, u.Subject(), []string{"user"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.UpdateDisabled(ctx, u.Subject(), true, "left"); err != nil {
+		t.Fatal(err)
+	}
+	found, err := d.FindUserByID(ctx, u.Subject())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Name != "Alice" || len(found.Roles) != 1 || found.Roles[0] != "user" || !found.Disabled || found.LockReason != "left" {
+		t.Fatalf("expected the updated user, got %+v", found)
+	}
+	users, err := d.FindUsers(ctx, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %v", len(users))
+	}
+	if err = d.DeleteUser(ctx, u.Subject()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = d.FindUserByID(ctx, u.Subject()); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected the user to be deleted, got %v", err)
+	}
+}
+
+func testUniqueUsers(t *testing.T, d Database) {
+	ctx := context.Background()
+	alice := &User{Username: "alice", Mail: "alice@example.com", Roles: []string{}}
+	if err := d.CreateUser(ctx, alice); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		user    User
+		wantErr error
+	}{
+		{name: "same username", user: User{Username: "alice"}, wantErr: ErrConflict},
+		{name: "same mail", user: User{Mail: "alice@example.com"}, wantErr: ErrConflict},
+		{name: "without mail", user: User{Username: "bob"}},
+		{name: "another user without mail", user: User{Username: "carol"}},
+		{name: "without username", user: User{Mail: "dave@example.com"}},
+		{name: "another user without username", user: User{Mail: "erin@example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := tt.user
+			u.Roles = []string{}
+			if err := d.CreateUser(ctx, &u); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+	bob, err := d.FindUserByUsernameOrMail(ctx, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob.Mail = alice.Mail
+	if err = d.UpdateUser(ctx, bob); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected a conflict when updating, got %v", err)
+	}
+}
+
+func testUserNotFound(t *testing.T, d Database) {
+	ctx := context.Background()
+	unknown := primitive.NewObjectID().Hex()
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "find by id", call: func() error { _, err := d.FindUserByID(ctx, unknown); return err }},
+		{name: "find by invalid id", call: func() error { _, err := d.FindUserByID(ctx, "invalid"); return err }},
+		{name: "find by username", call: func() error { _, err := d.FindUserByUsernameOrMail(ctx, "nobody"); return err }},
+		{name: "find by empty username", call: func() error { _, err := d.FindUserByUsernameOrMail(ctx, ""); return err }},
+		{name: "update", call: func() error { return d.UpdateUser(ctx, &User{ID: primitive.NewObjectID(), Username: "nobody"}) }},
+		{name: "update roles", call: func() error { return d.UpdateRoles(ctx, unknown, []string{}) }},
+		{name: "update disabled", call: func() error { return d.UpdateDisabled(ctx, unknown, true, "") }},
+		{name: "update password", call: func() error { return d.UpdatePassword(ctx, unknown, "hash") }},
+		{name: "update totp secret", call: func() error { return d.UpdateTOTPSecret(ctx, unknown, "secret") }},
+		{name: "update totp step", call: func() error { return d.UpdateTOTPStep(ctx, unknown, 1) }},
+		{name: "update webauthn credentials", call: func() error { return d.UpdateWebAuthnCredentials(ctx, unknown, nil) }},
+		{name: "delete", call: func() error { return d.DeleteUser(ctx, unknown) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrUserNotFound) {
+				t.Fatalf("expected an error wrapping ErrUserNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func testTOTPStep(t *testing.T, d Database) {
+	ctx := context.Background()
+	u := &User{Username: "alice", Roles: []string{}}
+	if err := d.CreateUser(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		step    int64
+		wantErr error
+	}{
+		{step: 5},
+		{step: 5, wantErr: ErrTOTPReplayed},
+		{step: 4, wantErr: ErrTOTPReplayed},
+		{step: 6},
+	} {
+		if err := d.UpdateTOTPStep(ctx, u.Subject(), tt.step); !errors.Is(err, tt.wantErr) {
+			t.Fatalf("step %v: expected error %v, got %v", tt.step, tt.wantErr, err)
+		}
+	}
+}
+
+func testLoginThrottles(t *testing.T, d Database) {
+	ctx := context.Background()
+	lt, err := d.FindLoginThrottle(ctx, "user:alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lt.Failures != 0 || lt.Blocked() {
+		t.Fatalf("expected no failures, got %+v", lt)
+	}
+	for i := 1; i <= 3; i++ {
+		lt, err = d.IncrementLoginFailures(ctx, "user:alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lt.Failures != i {
+			t.Fatalf("expected %v failures, got %v", i, lt.Failures)
+		}
+	}
+	// attempts which did not fail are removed again
+	if lt, err = d.DecrementLoginFailures(ctx, "user:alice"); err != nil || lt.Failures != 2 {
+		t.Fatalf("expected 2 failures after decrementing, got %+v, %v", lt, err)
+	}
+	if lt, err = d.IncrementLoginFailures(ctx, "user:alice"); err != nil || lt.Failures != 3 {
+		t.Fatalf("expected 3 failures, got %+v, %v", lt, err)
+	}
+	if lt, err = d.DecrementLoginFailures(ctx, "user:unknown"); err != nil || lt.Failures != 0 {
+		t.Fatalf("expected no failures for an unknown key, got %+v, %v", lt, err)
+	}
+	if _, err = d.IncrementLoginFailures(ctx, "ip:127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.BlockLogin(ctx, "user:alice", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	lt, err = d.FindLoginThrottle(ctx, "user:alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lt.Failures != 3 || !lt.Blocked() || lt.LastFailure.IsZero() {
+		t.Fatalf("expected 3 failures and a block, got %+v", lt)
+	}
+	all, err := d.FindLoginThrottles(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Key != "ip:127.0.0.1" {
+		t.Fatalf("expected 2 throttles, the most recent first, got %+v", all)
+	}
+	if err = d.DeleteLoginThrottle(ctx, "user:alice"); err != nil {
+		t.Fatal(err)
+	}
+	lt, err = d.FindLoginThrottle(ctx, "user:alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lt.Failures != 0 || lt.Blocked() {
+		t.Fatalf("expected the throttle to be deleted, got %+v", lt)
+	}
+}
+
+func testPasswordResets(t *testing.T, d Database) {
+	ctx := context.Background()
+	reset := &PasswordReset{
+		ID:        "hash",
+		UserID:    primitive.NewObjectID().Hex(),
+		Challenge: "challenge",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	if err := d.CreatePasswordReset(ctx, reset); err != nil {
+		t.Fatal(err)
+	}
+	found, err := d.FindPasswordReset(ctx, reset.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.UserID != reset.UserID || found.Challenge != reset.Challenge || found.Expired() {
+		t.Fatalf("expected the password reset, got %+v", found)
+	}
+	if err = d.DeletePasswordReset(ctx, reset.ID); err != nil {
+		t.Fatal(err)
+	}
+	// each password reset can only be used once
+	if err = d.DeletePasswordReset(ctx, reset.ID); err == nil {
+		t.Fatal("expected deleting the password reset twice to fail")
+	}
+	if _, err = d.FindPasswordReset(ctx, reset.ID); err == nil {
+		t.Fatal("expected the password reset to be deleted")
+	}
+	// all requests of a user are removed at once
+	for _, id := range []string{"first", "second", "other"} {
+		r := *reset
+		r.ID = id
+		if id == "other" {
+			r.UserID = primitive.NewObjectID().Hex()
+		}
+		if err = d.CreatePasswordReset(ctx, &r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = d.DeletePasswordResets(ctx, reset.UserID); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"first", "second"} {
+		if _, err = d.FindPasswordReset(ctx, id); err == nil {
+			t.Fatalf("expected the password reset %v to be deleted", id)
+		}
+	}
+	if _, err = d.FindPasswordReset(ctx, "other"); err != nil {
+		t.Fatalf("expected the password reset of another user to be kept, got %v", err)
+	}
+}
+
+func TestExpiredLoginThrottles(t *testing.T) {
+	ctx := context.Background()
+	expired := time.Now().Add(-LoginThrottleTTL - time.Minute)
+	tests := []struct {
+		name        string
+		newDatabase func(t *testing.T) Database
+		// age moves the last failure of the given key into the past
+		age func(t *testing.T, d Database, key string)
+	}{
+		{
+			name: "memory",
+			newDatabase: func(t *testing.T) Database {
+				d, _ := NewMemoryDatabase()
+				return d
+			},
+			age: func(t *testing.T, d Database, key string) {
+				d.(*Memory).throttles[key].LastFailure = expired
+			},
+		},
+		{
+			name:        "sqlite",
+			newDatabase: newSQLiteDatabase,
+			age: func(t *testing.T, d Database, key string) {
+				if _, err := d.(*SQL).exec(ctx, "UPDATE login_throttles SET last_failure = ? WHERE throttle_key = ?", expired.UTC(), key); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.newDatabase(t)
+			for _, key := range []string{"totp:expired", "user:blocked"} {
+				if _, err := d.IncrementLoginFailures(ctx, key); err != nil {
+					t.Fatal(err)
+				}
+				tt.age(t, d, key)
+			}
+			if err := d.BlockLogin(ctx, "user:blocked", time.Now().Add(time.Hour)); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := d.IncrementLoginFailures(ctx, "ip:127.0.0.1"); err != nil {
+				t.Fatal(err)
+			}
+			all, err := d.FindLoginThrottles(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var keys []string
+			for _, lt := range all {
+				keys = append(keys, lt.Key)
+			}
+			if len(keys) != 2 || keys[0] != "ip:127.0.0.1" || keys[1] != "user:blocked" {
+				t.Fatalf("expected the expired throttle to be removed, got %v", keys)
+			}
+		})
+	}
+}
+
+func TestLegacyDatabase(t *testing.T) {
+	d, err := NewMemoryDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := &User{Username: "alice", Mail: "alice@example.com", Roles: []string{}}
+	if err = d.CreateUser(context.Background(), alice); err != nil {
+		t.Fatal(err)
+	}
+	legacy := LegacyDatabase{d}
+	u, err := legacy.FindUserByUsernameOrMail("alice@example.com")
+	if err != nil || u.ID != alice.ID {
+		t.Fatalf("expected to find alice by mail, got %v, %v", u, err)
+	}
+	if u, err = legacy.FindUserByID(alice.Subject()); err != nil || u.Username != "alice" {
+		t.Fatalf("expected to find alice by id, got %v, %v", u, err)
+	}
+	if _, err = legacy.FindUserByID(primitive.NewObjectID().Hex()); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
@@ -2,33 +2,92 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
 // Database describe all database interaction.
+// Methods searching for a single user return an error
+// wrapping ErrUserNotFound if the user does not exist.
 type Database interface {
-	Connect() error
-	Disconnect() error
-	FindUserByUsernameOrMail(string) (*User, error)
-	FindUserByID(string) (*User, error)
+	Connect(ctx context.Context) error
+	Disconnect(ctx context.Context) error
+	Ping(ctx context.Context) error
+	FindUserByUsernameOrMail(ctx context.Context, s string) (*User, error)
+	FindUserByID(ctx context.Context, id string) (*User, error)
+	FindUsers(ctx context.Context, skip int, limit int) ([]User, error)
+	CreateUser(ctx context.Context, u *User) error
+	UpdateUser(ctx context.Context, u *User) error
+	UpdateRoles(ctx context.Context, id string, roles []string) error
+	UpdateDisabled(ctx context.Context, id string, disabled bool, reason string) error
+	DeleteUser(ctx context.Context, id string) error
+	UpdateTOTPSecret(ctx context.Context, id string, secret string) error
+	UpdateTOTPStep(ctx context.Context, id string, step int64) error
+	UpdateWebAuthnCredentials(ctx context.Context, id string, credentials []webauthn.Credential) error
+	FindLoginThrottle(ctx context.Context, key string) (*LoginThrottle, error)
+	FindLoginThrottles(ctx context.Context) ([]LoginThrottle, error)
+	IncrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error)
+	DecrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error)
+	BlockLogin(ctx context.Context, key string, until time.Time) error
+	DeleteLoginThrottle(ctx context.Context, key string) error
+	UpdatePassword(ctx context.Context, id string, hash string) error
+	CreatePasswordReset(ctx context.Context, reset *PasswordReset) error
+	FindPasswordReset(ctx context.Context, id string) (*PasswordReset, error)
+	DeletePasswordReset(ctx context.Context, id string) error
+	DeletePasswordResets(ctx context.Context, userID string) error
 }
 
+// LegacyDatabase provides the user lookups without a context,
+// as they were available before the methods of Database took one.
+// All other methods are passed to the wrapped database.
+//
+// Deprecated: use the methods of Database, so the queries can be cancelled.
+type LegacyDatabase struct {
+	Database
+}
+
+// FindUserByUsernameOrMail is like Database.FindUserByUsernameOrMail using the background context.
+//
+// Deprecated: use Database.FindUserByUsernameOrMail, so the query can be cancelled.
+func (l LegacyDatabase) FindUserByUsernameOrMail(s string) (*User, error) {
+	return l.Database.FindUserByUsernameOrMail(context.Background(), s)
+}
+
+// FindUserByID is like Database.FindUserByID using the background context.
+//
+// Deprecated: use Database.FindUserByID, so the query can be cancelled.
+func (l LegacyDatabase) FindUserByID(id string) (*User, error) {
+	return l.Database.FindUserByID(context.Background(), id)
+}
+
+// ErrUserNotFound is returned if the requested user does not exist.
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrNotConnected is returned if the database is used before connecting.
+var ErrNotConnected = errors.New("not connected to the database")
+
 // MGO implements the database interface, representing a mongodb connection.
+// Each MGO owns its client, so multiple connections can be used side by side.
 type MGO struct {
-	url     string
-	dbname  string
-	colname string
+	url             string
+	dbname          string
+	colname         string
+	throttleColname string
+	resetColname    string
+	client          *mongo.Client
+	col             *mongo.Collection
+	throttleCol     *mongo.Collection
+	resetCol        *mongo.Collection
 }
 
-var _ Database = MGO{}
-
-var client *mongo.Client
-var col *mongo.Collection
+var _ Database = &MGO{}
 
 // NewMongoConnection creates a new mongo database connection.
 // It takes functional parameters to change default options
@@ -37,15 +96,17 @@ var col *mongo.Collection
 // something went wrong.
 func NewMongoConnection(opts ...func(*MGO) error) (Database, error) {
 	// create server with default options
-	var m = MGO{
-		url:     "mongodb://localhost:27017",
-		dbname:  "db",
-		colname: "users",
+	var m = &MGO{
+		url:             "mongodb://localhost:27017",
+		dbname:          "db",
+		colname:         "users",
+		throttleColname: "login_throttles",
+		resetColname:    "password_resets",
 	}
 
 	// run functional options
 	for _, op := range opts {
-		err := op(&m)
+		err := op(m)
 		if err != nil {
 			return nil, fmt.Errorf("setting option failed: %w", err)
 		}
@@ -77,33 +138,118 @@ func SetCollectionName(colname string) func(*MGO) error {
 	}
 }
 
+// SetThrottleCollectionName changes the name of the mongodb collection
+// in which failed login attempts are stored.
+func SetThrottleCollectionName(colname string) func(*MGO) error {
+	return func(m *MGO) error {
+		m.throttleColname = colname
+		return nil
+	}
+}
+
+// SetResetCollectionName changes the name of the mongodb collection
+// in which password reset requests are stored.
+func SetResetCollectionName(colname string) func(*MGO) error {
+	return func(m *MGO) error {
+		m.resetColname = colname
+		return nil
+	}
+}
+
 // Connect establishes a connection to a mongodb server.
-func (m MGO) Connect() error {
-	var err error
-	client, err = mongo.NewClient(options.Client().ApplyURI(m.url))
+func (m *MGO) Connect(ctx context.Context) error {
+	client, err := mongo.NewClient(options.Client().ApplyURI(m.url))
 	if err != nil {
 		return err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	connectCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
 	defer cancel()
-	err = client.Connect(ctx)
-	if err != nil {
+	if err = client.Connect(connectCtx); err != nil {
 		return err
 	}
-	ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+	pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	err = client.Ping(ctx, readpref.Primary())
-	if err != nil {
+	if err = client.Ping(pingCtx, readpref.Primary()); err != nil {
+		client.Disconnect(context.Background())
 		return err
 	}
-	col = client.Database(m.dbname).Collection(m.colname)
+	m.client = client
+	m.col = client.Database(m.dbname).Collection(m.colname)
+	m.throttleCol = client.Database(m.dbname).Collection(m.throttleColname)
+	m.resetCol = client.Database(m.dbname).Collection(m.resetColname)
+	if err = m.createIndexes(ctx); err != nil {
+		client.Disconnect(context.Background())
+		return fmt.Errorf("unable to create indexes: %w", err)
+	}
+	return nil
+}
+
+// createIndexes creates the indexes needed by godra,
+// existing indexes are left unchanged.
+// Creating the unique indexes fails if the collection
+// already contains users with the same username or mail address.
+func (m *MGO) createIndexes(ctx context.Context) error {
+	// usernames and mail addresses are unique, users without them are not indexed
+	_, err := m.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "username", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"username": bson.M{"$gt": ""}}),
+		},
+		{
+			Keys:    bson.D{{Key: "mail", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"mail": bson.M{"$gt": ""}}),
+		},
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	// remove expired login throttles
+	_, err = m.throttleCol.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
 }
 
 // Disconnect closes the connection to the mongodb server.
-func (MGO) Disconnect() error {
-	return client.Disconnect(context.TODO())
+func (m *MGO) Disconnect(ctx context.Context) error {
+	if m.client == nil {
+		return ErrNotConnected
+	}
+	return m.client.Disconnect(ctx)
+}
+
+// Ping verifies that the mongodb server is reachable.
+func (m *MGO) Ping(ctx context.Context) error {
+	if m.client == nil {
+		return ErrNotConnected
+	}
+	return m.client.Ping(ctx, readpref.Primary())
+}
+
+// users returns the collection of the users or
+// ErrNotConnected if Connect was not called yet.
+func (m *MGO) users() (*mongo.Collection, error) {
+	if m.col == nil {
+		return nil, ErrNotConnected
+	}
+	return m.col, nil
+}
+
+// throttles returns the collection of the login throttles or
+// ErrNotConnected if Connect was not called yet.
+func (m *MGO) throttles() (*mongo.Collection, error) {
+	if m.throttleCol == nil {
+		return nil, ErrNotConnected
+	}
+	return m.throttleCol, nil
+}
+
+// resets returns the collection of the password resets or
+// ErrNotConnected if Connect was not called yet.
+func (m *MGO) resets() (*mongo.Collection, error) {
+	if m.resetCol == nil {
+		return nil, ErrNotConnected
+	}
+	return m.resetCol, nil
 }
@@ -0,0 +1,590 @@
+package db
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// LDAP implements the database interface, reading the users from
+// an ldap directory, such as active directory. Passwords are
+// validated using a bind with the user's dn and the users
+// cannot be changed. Failed login attempts and password resets
+// are kept in a separate store, in memory by default.
+type LDAP struct {
+	url          string
+	startTLS     bool
+	bindDN       string
+	bindPassword string
+	userBaseDN   string
+	userFilter   string
+	groupBaseDN  string
+	groupFilter  string
+	attributes   LDAPAttributes
+	timeout      time.Duration
+	store        Database
+}
+
+var _ Database = &LDAP{}
+var _ PasswordValidator = &LDAP{}
+var _ ReadOnlyDatabase = &LDAP{}
+
+// LDAPAttributes contains the names of the ldap attributes
+// which are mapped to the user's fields.
+type LDAPAttributes struct {
+	// ID is a stable, unique attribute, such as
+	// entryUUID or objectGUID, used as subject.
+	ID       string
+	Username string
+	Name     string
+	Mail     string
+	// Group is the attribute of groups used as role name.
+	Group string
+}
+
+// active directory's flag for disabled accounts in userAccountControl.
+const adAccountDisable = 0x2
+
+// NewLDAPConnection creates a new ldap database connection.
+// It takes functional parameters to change default options
+// such as the url of the ldap server.
+// It returns the newly created connection or an error if
+// something went wrong.
+func NewLDAPConnection(opts ...func(*LDAP) error) (Database, error) {
+	l := &LDAP{
+		url:         "ldap://localhost:389",
+		userFilter:  "(|(uid={username})(mail={username}))",
+		groupFilter: "(|(member={dn})(uniqueMember={dn}))",
+		attributes: LDAPAttributes{
+			ID:       "entryUUID",
+			Username: "uid",
+			Name:     "cn",
+			Mail:     "mail",
+			Group:    "cn",
+		},
+		timeout: 10 * time.Second,
+	}
+	for _, op := range opts {
+		err := op(l)
+		if err != nil {
+			return nil, fmt.Errorf("setting option failed: %w", err)
+		}
+	}
+	if l.userBaseDN == "" {
+		return nil, fmt.Errorf("no user base dn given")
+	}
+	if l.store == nil {
+		store, err := NewMemoryDatabase()
+		if err != nil {
+			return nil, err
+		}
+		l.store = store
+	}
+	return l, nil
+}
+
+// SetLDAPURL sets the url of the ldap server, such as ldaps://ldap.example.com.
+func SetLDAPURL(u string) func(*LDAP) error {
+	return func(l *LDAP) error {
+		if _, err := url.Parse(u); err != nil {
+			return fmt.Errorf("invalid ldap url '%s': %w", u, err)
+		}
+		l.url = u
+		return nil
+	}
+}
+
+// SetLDAPStartTLS enables upgrading ldap:// connections using StartTLS.
+func SetLDAPStartTLS(startTLS bool) func(*LDAP) error {
+	return func(l *LDAP) error {
+		l.startTLS = startTLS
+		return nil
+	}
+}
+
+// SetLDAPBind sets the credentials used to search the directory.
+// Without credentials, the searches are anonymous.
+func SetLDAPBind(dn string, password string) func(*LDAP) error {
+	return func(l *LDAP) error {
+		l.bindDN = dn
+		l.bindPassword = password
+		return nil
+	}
+}
+
+// SetLDAPUserSearch sets the base dn and the filter used to search users.
+// The placeholder {username} in the filter is replaced with the
+// username or mail address entered by the user.
+func SetLDAPUserSearch(baseDN string, filter string) func(*LDAP) error {
+	return func(l *LDAP) error {
+		if !strings.Contains(filter, "{username}") {
+			return fmt.Errorf("the user filter needs to contain {username}")
+		}
+		if _, err := ldap.CompileFilter(strings.ReplaceAll(filter, "{username}", "x")); err != nil {
+			return fmt.Errorf("invalid user filter: %w", err)
+		}
+		l.userBaseDN = baseDN
+		l.userFilter = filter
+		return nil
+	}
+}
+
+// SetLDAPGroupSearch sets the base dn and the filter used to search
+// the groups of a user, which are mapped to the user's roles.
+// The placeholder {dn} in the filter is replaced with the user's dn.
+// Without base dn, the groups are read from the user's memberOf attribute.
+func SetLDAPGroupSearch(baseDN string, filter string) func(*LDAP) error {
+	return func(l *LDAP) error {
+		if baseDN != "" {
+			if _, err := ldap.CompileFilter(strings.ReplaceAll(filter, "{dn}", "x")); err != nil {
+				return fmt.Errorf("invalid group filter: %w", err)
+			}
+		}
+		l.groupBaseDN = baseDN
+		l.groupFilter = filter
+		return nil
+	}
+}
+
+// SetLDAPAttributes changes the names of the mapped ldap attributes.
+// Empty names keep the default.
+func SetLDAPAttributes(attributes LDAPAttributes) func(*LDAP) error {
+	return func(l *LDAP) error {
+		for _, a := range []struct {
+			from string
+			to   *string
+		}{
+			{attributes.ID, &l.attributes.ID},
+			{attributes.Username, &l.attributes.Username},
+			{attributes.Name, &l.attributes.Name},
+			{attributes.Mail, &l.attributes.Mail},
+			{attributes.Group, &l.attributes.Group},
+		} {
+			if a.from != "" {
+				*a.to = a.from
+			}
+		}
+		return nil
+	}
+}
+
+// SetLDAPTimeout sets the timeout of ldap requests.
+func SetLDAPTimeout(timeout time.Duration) func(*LDAP) error {
+	return func(l *LDAP) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid ldap timeout: %v", timeout)
+		}
+		l.timeout = timeout
+		return nil
+	}
+}
+
+// SetLDAPStore sets the database in which failed login
+// attempts and password resets are stored.
+func SetLDAPStore(store Database) func(*LDAP) error {
+	return func(l *LDAP) error {
+		l.store = store
+		return nil
+	}
+}
+
+// Connect verifies that the ldap server is reachable
+// using the configured credentials and connects the store.
+func (l *LDAP) Connect(ctx context.Context) error {
+	conn, err := l.dial(ctx)
+	if err != nil {
+		return err
+	}
+	conn.Close()
+	return l.store.Connect(ctx)
+}
+
+// Disconnect disconnects the store.
+// There is no permanent connection to the ldap server.
+func (l *LDAP) Disconnect(ctx context.Context) error {
+	return l.store.Disconnect(ctx)
+}
+
+// Ping verifies that the ldap server is reachable
+// using the configured credentials and pings the store.
+func (l *LDAP) Ping(ctx context.Context) error {
+	conn, err := l.dial(ctx)
+	if err != nil {
+		return err
+	}
+	conn.Close()
+	return l.store.Ping(ctx)
+}
+
+// dial connects to the ldap server and binds using the search credentials.
+// The timeout is shortened if the given context expires earlier.
+func (l *LDAP) dial(ctx context.Context) (*ldap.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	timeout := l.timeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	conn, err := ldap.DialURL(l.url, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to ldap server: %w", err)
+	}
+	conn.SetTimeout(timeout)
+	if l.startTLS {
+		u, _ := url.Parse(l.url)
+		if err = conn.StartTLS(&tls.Config{ServerName: u.Hostname()}); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap starttls failed: %w", err)
+		}
+	}
+	if l.bindDN != "" {
+		if err = conn.Bind(l.bindDN, l.bindPassword); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap bind as '%s' failed: %w", l.bindDN, err)
+		}
+	}
+	return conn, nil
+}
+
+// userAttributes returns the attributes to read from user entries.
+func (l *LDAP) userAttributes() []string {
+	attrs := []string{l.attributes.ID, l.attributes.Username, l.attributes.Name, l.attributes.Mail, "userAccountControl"}
+	if l.groupBaseDN == "" {
+		attrs = append(attrs, "memberOf")
+	}
+	return attrs
+}
+
+// searchUsers returns the user entries matching the given filter.
+func (l *LDAP) searchUsers(conn *ldap.Conn, filter string) ([]*ldap.Entry, error) {
+	req := ldap.NewSearchRequest(l.userBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, 0, false, filter, l.userAttributes(), nil)
+	res, err := conn.SearchWithPaging(req, 500)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ldap search failed: %w", err)
+	}
+	return res.Entries, nil
+}
+
+// findEntry returns the single user entry matching the given filter.
+// The notFound error is returned if there is none.
+func (l *LDAP) findEntry(conn *ldap.Conn, filter string, notFound error) (*ldap.Entry, error) {
+	entries, err := l.searchUsers(conn, filter)
+	if err != nil {
+		return nil, err
+	}
+	switch len(entries) {
+	case 0:
+		return nil, notFound
+	case 1:
+		return entries[0], nil
+	default:
+		return nil, fmt.Errorf("ldap search returned %v users for filter %s", len(entries), filter)
+	}
+}
+
+// idFilter returns the filter matching the user with the given id.
+func (l *LDAP) idFilter(id string) (string, error) {
+	if strings.EqualFold(l.attributes.ID, "objectGUID") {
+		b, err := parseGUID(id)
+		if err != nil {
+			return "", err
+		}
+		var v strings.Builder
+		for _, c := range b {
+			fmt.Fprintf(&v, "\\%02x", c)
+		}
+		return fmt.Sprintf("(%s=%s)", l.attributes.ID, v.String()), nil
+	}
+	return fmt.Sprintf("(%s=%s)", l.attributes.ID, ldap.EscapeFilter(id)), nil
+}
+
+// toUser creates the user from the given ldap entry.
+func (l *LDAP) toUser(conn *ldap.Conn, e *ldap.Entry) (*User, error) {
+	id := e.GetAttributeValue(l.attributes.ID)
+	if strings.EqualFold(l.attributes.ID, "objectGUID") {
+		id = formatGUID(e.GetRawAttributeValue(l.attributes.ID))
+	}
+	if id == "" {
+		return nil, fmt.Errorf("ldap user %s has no %s attribute", e.DN, l.attributes.ID)
+	}
+	u := &User{
+		ExternalID: id,
+		Username:   e.GetAttributeValue(l.attributes.Username),
+		Name:       e.GetAttributeValue(l.attributes.Name),
+		Mail:       e.GetAttributeValue(l.attributes.Mail),
+		Roles:      []string{},
+	}
+	if uac, err := strconv.Atoi(e.GetAttributeValue("userAccountControl")); err == nil && uac&adAccountDisable != 0 {
+		u.Disabled = true
+	}
+	if l.groupBaseDN == "" {
+		for _, dn := range e.GetAttributeValues("memberOf") {
+			if name := groupName(dn, l.attributes.Group); name != "" {
+				u.Roles = append(u.Roles, name)
+			}
+		}
+		return u, nil
+	}
+	filter := strings.ReplaceAll(l.groupFilter, "{dn}", ldap.EscapeFilter(e.DN))
+	req := ldap.NewSearchRequest(l.groupBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, 0, false, filter, []string{l.attributes.Group}, nil)
+	res, err := conn.SearchWithPaging(req, 500)
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+		return nil, fmt.Errorf("ldap group search failed: %w", err)
+	}
+	if res != nil {
+		for _, g := range res.Entries {
+			if name := g.GetAttributeValue(l.attributes.Group); name != "" {
+				u.Roles = append(u.Roles, name)
+			}
+		}
+	}
+	return u, nil
+}
+
+// groupName returns the value of the given attribute
+// from the first relative dn of the group's dn.
+func groupName(dn string, attr string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 {
+		return ""
+	}
+	for _, a := range parsed.RDNs[0].Attributes {
+		if strings.EqualFold(a.Type, attr) {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// formatGUID formats active directory's binary objectGUID
+// as string, with the first three groups in little endian.
+func formatGUID(b []byte) string {
+	if len(b) != 16 {
+		return ""
+	}
+	return fmt.Sprintf("%02x%02x%02x%02x-%02x%02x-%02x%02x-%x-%x",
+		b[3], b[2], b[1], b[0], b[5], b[4], b[7], b[6], b[8:10], b[10:])
+}
+
+// parseGUID is the inverse of formatGUID.
+func parseGUID(s string) ([]byte, error) {
+	h, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(h) != 16 || len(s) != 36 {
+		return nil, fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, s)
+	}
+	return []byte{h[3], h[2], h[1], h[0], h[5], h[4], h[7], h[6],
+		h[8], h[9], h[10], h[11], h[12], h[13], h[14], h[15]}, nil
+}
+
+// FindUserByUsernameOrMail searches for a user which has the given string as username or mail address.
+func (l *LDAP) FindUserByUsernameOrMail(ctx context.Context, s string) (*User, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: '%s'", ErrUserNotFound, s)
+	}
+	conn, err := l.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	filter := strings.ReplaceAll(l.userFilter, "{username}", ldap.EscapeFilter(s))
+	e, err := l.findEntry(conn, filter, fmt.Errorf("%w: '%s'", ErrUserNotFound, s))
+	if err != nil {
+		return nil, err
+	}
+	return l.toUser(conn, e)
+}
+
+// FindUserByID searches for a user with the given id.
+func (l *LDAP) FindUserByID(ctx context.Context, id string) (*User, error) {
+	filter, err := l.idFilter(id)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := l.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	e, err := l.findEntry(conn, filter, fmt.Errorf("%w: %s", ErrUserNotFound, id))
+	if err != nil {
+		return nil, err
+	}
+	return l.toUser(conn, e)
+}
+
+// FindUsers returns the users ordered by id.
+// It skips the given number of users and returns at most limit users.
+func (l *LDAP) FindUsers(ctx context.Context, skip int, limit int) ([]User, error) {
+	conn, err := l.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	entries, err := l.searchUsers(conn, strings.ReplaceAll(l.userFilter, "{username}", "*"))
+	if err != nil {
+		return nil, err
+	}
+	var all []User
+	for _, e := range entries {
+		u, err := l.toUser(conn, e)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, *u)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ExternalID < all[j].ExternalID
+	})
+	users := []User{}
+	for i := skip; i < len(all) && (limit <= 0 || len(users) < limit); i++ {
+		users = append(users, all[i])
+	}
+	return users, nil
+}
+
+// ValidatePassword validates the given plaintext password
+// for the user using a bind with the user's dn.
+func (l *LDAP) ValidatePassword(ctx context.Context, u *User, plainPassword string) error {
+	// an empty password would result in an unauthenticated bind,
+	// which succeeds without validating anything
+	if plainPassword == "" {
+		return fmt.Errorf("%w: empty password", ErrInvalidCredentials)
+	}
+	filter, err := l.idFilter(u.ExternalID)
+	if err != nil {
+		return err
+	}
+	conn, err := l.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	e, err := l.findEntry(conn, filter, fmt.Errorf("%w: %s", ErrUserNotFound, u.ExternalID))
+	if err != nil {
+		return err
+	}
+	err = conn.Bind(e.DN, plainPassword)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return fmt.Errorf("%w: ldap bind as '%s' failed: %v", ErrInvalidCredentials, e.DN, err)
+	}
+	if err != nil {
+		return fmt.Errorf("ldap bind as '%s' failed: %w", e.DN, err)
+	}
+	return nil
+}
+
+// CreateUser returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) CreateUser(ctx context.Context, u *User) error {
+	return ErrReadOnly
+}
+
+// UpdateUser returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdateUser(ctx context.Context, u *User) error {
+	return ErrReadOnly
+}
+
+// UpdateRoles returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	return ErrReadOnly
+}
+
+// UpdateDisabled returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdateDisabled(ctx context.Context, id string, disabled bool, reason string) error {
+	return ErrReadOnly
+}
+
+// DeleteUser returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) DeleteUser(ctx context.Context, id string) error {
+	return ErrReadOnly
+}
+
+// ReadOnly returns true, as the users are managed in the directory.
+func (l *LDAP) ReadOnly() bool {
+	return true
+}
+
+// UpdateTOTPSecret returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdateTOTPSecret(ctx context.Context, id string, secret string) error {
+	return ErrReadOnly
+}
+
+// UpdateTOTPStep returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdateTOTPStep(ctx context.Context, id string, step int64) error {
+	return ErrReadOnly
+}
+
+// UpdateWebAuthnCredentials returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdateWebAuthnCredentials(ctx context.Context, id string, credentials []webauthn.Credential) error {
+	return ErrReadOnly
+}
+
+// UpdatePassword returns ErrReadOnly, as the users are managed in the directory.
+func (l *LDAP) UpdatePassword(ctx context.Context, id string, hash string) error {
+	return ErrReadOnly
+}
+
+// FindLoginThrottle searches for the failed login attempts in the store.
+func (l *LDAP) FindLoginThrottle(ctx context.Context, key string) (*LoginThrottle, error) {
+	return l.store.FindLoginThrottle(ctx, key)
+}
+
+// FindLoginThrottles returns all failed login attempts from the store.
+func (l *LDAP) FindLoginThrottles(ctx context.Context) ([]LoginThrottle, error) {
+	return l.store.FindLoginThrottles(ctx)
+}
+
+// IncrementLoginFailures registers a failed login attempt in the store.
+func (l *LDAP) IncrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	return l.store.IncrementLoginFailures(ctx, key)
+}
+
+// DecrementLoginFailures removes a registered login attempt from the store.
+func (l *LDAP) DecrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	return l.store.DecrementLoginFailures(ctx, key)
+}
+
+// BlockLogin blocks logins for the given key until the given time.
+func (l *LDAP) BlockLogin(ctx context.Context, key string, until time.Time) error {
+	return l.store.BlockLogin(ctx, key, until)
+}
+
+// DeleteLoginThrottle removes all failed login attempts for the given key.
+func (l *LDAP) DeleteLoginThrottle(ctx context.Context, key string) error {
+	return l.store.DeleteLoginThrottle(ctx, key)
+}
+
+// CreatePasswordReset stores the password reset request in the store.
+func (l *LDAP) CreatePasswordReset(ctx context.Context, reset *PasswordReset) error {
+	return l.store.CreatePasswordReset(ctx, reset)
+}
+
+// FindPasswordReset searches for the password reset request in the store.
+func (l *LDAP) FindPasswordReset(ctx context.Context, id string) (*PasswordReset, error) {
+	return l.store.FindPasswordReset(ctx, id)
+}
+
+// DeletePasswordReset removes the password reset request from the store.
+func (l *LDAP) DeletePasswordReset(ctx context.Context, id string) error {
+	return l.store.DeletePasswordReset(ctx, id)
+}
+
+// DeletePasswordResets removes the user's password reset requests from the store.
+func (l *LDAP) DeletePasswordResets(ctx context.Context, userID string) error {
+	return l.store.DeletePasswordResets(ctx, userID)
+}
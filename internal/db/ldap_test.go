@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rbicker/godra/internal/db/ldaptest"
+)
+
+func TestLDAPValidatePassword(t *testing.T) {
+	ctx := context.Background()
+	d := ldaptest.NewServer()
+	defer d.Close()
+	d.AddEntry(ldaptest.Entry{
+		DN:       "uid=alice,ou=people,dc=example,dc=org",
+		Password: "secret-password",
+		Attributes: map[string][]string{
+			"uid":       {"alice"},
+			"entryUUID": {"9b2c8f0e-7c1a-4d1e-9a57-3f0c1e5b2a11"},
+		},
+	})
+	con, err := NewLDAPConnection(SetLDAPURL(d.URL), SetLDAPUserSearch("ou=people,dc=example,dc=org", "(uid={username})"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := con.FindUserByUsernameOrMail(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{name: "valid", password: "secret-password"},
+		{name: "wrong", password: "wrong-password", wantErr: ErrInvalidCredentials},
+		{name: "empty", password: "", wantErr: ErrInvalidCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckPassword(ctx, con, u, tt.password); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+	// an unreachable directory says nothing about the password
+	d.Close()
+	err = CheckPassword(ctx, con, u, "wrong-password")
+	if err == nil || errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("expected an error other than ErrInvalidCredentials, got %v", err)
+	}
+}
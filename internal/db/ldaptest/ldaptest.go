@@ -0,0 +1,273 @@
+// Package ldaptest provides an in-process ldap directory
+// for testing the ldap database against.
+//
+// The directory supports simple binds and searches with the
+// usual filters. Entries are added by the test:
+//
+//	d := ldaptest.NewServer()
+//	defer d.Close()
+//	d.AddEntry(ldaptest.Entry{
+//		DN:       "uid=alice,ou=people,dc=example,dc=org",
+//		Password: "secret",
+//		Attributes: map[string][]string{
+//			"uid":       {"alice"},
+//			"entryUUID": {"9b2c8f0e-7c1a-4d1e-9a57-3f0c1e5b2a11"},
+//		},
+//	})
+//	con, _ := db.NewLDAPConnection(db.SetLDAPURL(d.URL), db.SetLDAPUserSearch("ou=people,dc=example,dc=org", "(uid={username})"))
+package ldaptest
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/jimlambrt/gldap"
+)
+
+// Entry is an entry of the directory.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+	// Password allows binding as the entry if set.
+	Password string
+}
+
+// get returns the values of the given attribute, ignoring the case of its name.
+func (e Entry) get(attr string) []string {
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attr) {
+			return values
+		}
+	}
+	return nil
+}
+
+// Server is an in-process ldap directory.
+type Server struct {
+	// URL of the directory, such as ldap://127.0.0.1:12345.
+	URL     string
+	srv     *gldap.Server
+	mu      sync.Mutex
+	entries []Entry
+	binds   []string
+}
+
+// NewServer starts and returns a new, empty directory.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{}
+	srv, err := gldap.NewServer()
+	if err != nil {
+		panic(fmt.Sprintf("ldaptest: unable to create server: %v", err))
+	}
+	mux, err := gldap.NewMux()
+	if err != nil {
+		panic(fmt.Sprintf("ldaptest: unable to create mux: %v", err))
+	}
+	mux.Bind(s.handleBind)
+	mux.Search(s.handleSearch)
+	srv.Router(mux)
+	// find a free port, gldap does not expose its listener
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("ldaptest: unable to find a free port: %v", err))
+	}
+	addr := l.Addr().String()
+	l.Close()
+	go srv.Run(addr)
+	for i := 0; !srv.Ready(); i++ {
+		if i == 500 {
+			panic("ldaptest: server did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.srv = srv
+	s.URL = "ldap://" + addr
+	return s
+}
+
+// Close shuts down the directory.
+func (s *Server) Close() {
+	s.srv.Stop()
+}
+
+// AddEntry adds the given entry to the directory.
+func (s *Server) AddEntry(e Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+}
+
+// Binds returns the dns of all successful authenticated binds.
+func (s *Server) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.binds...)
+}
+
+// handleBind accepts simple binds with the password of an entry.
+// Binds with an empty password are unauthenticated and always succeed,
+// like on most real directories.
+func (s *Server) handleBind(w *gldap.ResponseWriter, r *gldap.Request) {
+	res := r.NewBindResponse(gldap.WithResponseCode(gldap.ResultInvalidCredentials))
+	defer w.Write(res)
+	m, err := r.GetSimpleBindMessage()
+	if err != nil {
+		return
+	}
+	if m.Password == "" {
+		res.SetResultCode(gldap.ResultSuccess)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, m.UserName) && e.Password != "" && e.Password == string(m.Password) {
+			s.binds = append(s.binds, e.DN)
+			res.SetResultCode(gldap.ResultSuccess)
+			return
+		}
+	}
+}
+
+// handleSearch returns the entries within the base dn and scope
+// of the search request, which match its filter.
+func (s *Server) handleSearch(w *gldap.ResponseWriter, r *gldap.Request) {
+	res := r.NewSearchDoneResponse(gldap.WithResponseCode(gldap.ResultOperationsError))
+	defer func() { w.Write(res) }()
+	m, err := r.GetSearchMessage()
+	if err != nil {
+		return
+	}
+	filter, err := ldap.CompileFilter(m.Filter)
+	if err != nil {
+		res.SetResultCode(gldap.ResultFilterError)
+		res.SetDiagnosticMessage(err.Error())
+		return
+	}
+	s.mu.Lock()
+	var found []Entry
+	for _, e := range s.entries {
+		if inScope(e.DN, m.BaseDN, m.Scope) && matches(filter, e) {
+			found = append(found, e)
+		}
+	}
+	s.mu.Unlock()
+	for _, e := range found {
+		entry := r.NewSearchResponseEntry(e.DN)
+		for name, values := range e.Attributes {
+			if requested(name, m.Attributes) {
+				entry.AddAttribute(name, values)
+			}
+		}
+		w.Write(entry)
+	}
+	res.SetResultCode(gldap.ResultSuccess)
+}
+
+// inScope returns true if the entry with the given dn
+// is within the given base dn and scope.
+func inScope(dn string, baseDN string, scope gldap.Scope) bool {
+	dn, baseDN = strings.ToLower(dn), strings.ToLower(baseDN)
+	if dn == baseDN {
+		return scope != gldap.SingleLevel
+	}
+	if !strings.HasSuffix(dn, ","+baseDN) {
+		return false
+	}
+	switch scope {
+	case gldap.BaseObject:
+		return false
+	case gldap.SingleLevel:
+		return !strings.Contains(strings.TrimSuffix(dn, ","+baseDN), ",")
+	default:
+		return true
+	}
+}
+
+// requested returns true if the attribute with the given name
+// should be returned for the requested attributes.
+func requested(name string, attributes []string) bool {
+	if len(attributes) == 0 {
+		return true
+	}
+	for _, a := range attributes {
+		if a == "*" || strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches evaluates the compiled filter for the given entry.
+// Values are compared ignoring their case.
+func matches(f *ber.Packet, e Entry) bool {
+	switch f.Tag {
+	case ldap.FilterAnd:
+		for _, c := range f.Children {
+			if !matches(c, e) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, c := range f.Children {
+			if matches(c, e) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return len(f.Children) == 1 && !matches(f.Children[0], e)
+	case ldap.FilterPresent:
+		return strings.EqualFold(f.Data.String(), "objectClass") || len(e.get(f.Data.String())) > 0
+	case ldap.FilterEqualityMatch, ldap.FilterApproxMatch:
+		want := f.Children[1].Data.String()
+		for _, v := range e.get(f.Children[0].Data.String()) {
+			if strings.EqualFold(v, want) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterSubstrings:
+		for _, v := range e.get(f.Children[0].Data.String()) {
+			if matchSubstrings(strings.ToLower(v), f.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// matchSubstrings returns true if the value matches the initial,
+// any and final parts of a substrings filter.
+func matchSubstrings(v string, parts []*ber.Packet) bool {
+	for _, p := range parts {
+		sub := strings.ToLower(p.Data.String())
+		switch p.Tag {
+		case ldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(v, sub) {
+				return false
+			}
+			v = v[len(sub):]
+		case ldap.FilterSubstringsAny:
+			i := strings.Index(v, sub)
+			if i < 0 {
+				return false
+			}
+			v = v[i+len(sub):]
+		case ldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(v, sub) {
+				return false
+			}
+		}
+	}
+	return true
+}
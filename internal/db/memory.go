@@ -0,0 +1,534 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/rbicker/godra/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Memory implements the database interface, keeping all data in memory.
+// It is intended for demos, local development and tests.
+// All changes are lost when the process exits.
+type Memory struct {
+	seedFile  string
+	mu        sync.RWMutex
+	users     map[primitive.ObjectID]*User
+	throttles map[string]*LoginThrottle
+	resets    map[string]*PasswordReset
+}
+
+var _ Database = &Memory{}
+
+// SeedUser represents a user in the seed file of the in-memory database.
+// Either a plaintext password, which gets hashed,
+// or an existing bcrypt hash can be given.
+type SeedUser struct {
+	// ID is the hex encoded object id of the user. It should be set
+	// to keep the subject of the user stable across restarts.
+	ID           string   `json:"id"`
+	Username     string   `json:"username"`
+	Name         string   `json:"name"`
+	Mail         string   `json:"mail"`
+	MailVerified bool     `json:"mail_verified"`
+	Password     string   `json:"password"`
+	PasswordHash string   `json:"password_hash"`
+	Roles        []string `json:"roles"`
+	Disabled     bool     `json:"disabled"`
+	TOTPSecret   string   `json:"totp_secret"`
+}
+
+// seed represents the seed file of the in-memory database.
+type seed struct {
+	Users []SeedUser `json:"users"`
+}
+
+// NewMemoryDatabase creates a new, empty in-memory database.
+// It takes functional parameters to change default options
+// such as the seed file.
+// It returns the newly created database or an error if
+// something went wrong.
+func NewMemoryDatabase(opts ...func(*Memory) error) (Database, error) {
+	m := &Memory{
+		users:     make(map[primitive.ObjectID]*User),
+		throttles: make(map[string]*LoginThrottle),
+		resets:    make(map[string]*PasswordReset),
+	}
+	for _, op := range opts {
+		err := op(m)
+		if err != nil {
+			return nil, fmt.Errorf("setting option failed: %w", err)
+		}
+	}
+	return m, nil
+}
+
+// SetSeedFile sets the yaml or json file containing
+// the users, which are loaded when connecting.
+func SetSeedFile(path string) func(*Memory) error {
+	return func(m *Memory) error {
+		m.seedFile = path
+		return nil
+	}
+}
+
+// Connect loads the users from the seed file, if one is configured.
+func (m *Memory) Connect(ctx context.Context) error {
+	if m.seedFile == "" {
+		return nil
+	}
+	var s seed
+	if err := utils.LoadConfigFile(m.seedFile, &s); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, su := range s.Users {
+		u, err := su.user()
+		if err != nil {
+			return fmt.Errorf("invalid user %v in seed file: %w", i+1, err)
+		}
+		if _, ok := m.users[u.ID]; ok {
+			return fmt.Errorf("invalid user %v in seed file: duplicate id %s", i+1, u.ID.Hex())
+		}
+		if m.findByUsernameOrMail(u.Username) != nil || m.findByUsernameOrMail(u.Mail) != nil {
+			return fmt.Errorf("invalid user %v in seed file: username or mail already in use", i+1)
+		}
+		m.users[u.ID] = u
+	}
+	return nil
+}
+
+// user validates the seed user and creates the user from it.
+func (su SeedUser) user() (*User, error) {
+	if su.Username == "" && su.Mail == "" {
+		return nil, fmt.Errorf("username or mail needs to be set")
+	}
+	u := &User{
+		ID:           primitive.NewObjectID(),
+		Username:     su.Username,
+		Name:         su.Name,
+		Mail:         su.Mail,
+		MailVerified: su.MailVerified,
+		Password:     su.PasswordHash,
+		Roles:        su.Roles,
+		Disabled:     su.Disabled,
+		TOTPSecret:   su.TOTPSecret,
+	}
+	if su.ID != "" {
+		oid, err := primitive.ObjectIDFromHex(su.ID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse id: %v", su.ID)
+		}
+		u.ID = oid
+	}
+	if u.Roles == nil {
+		u.Roles = []string{}
+	}
+	switch {
+	case su.Password != "" && su.PasswordHash != "":
+		return nil, fmt.Errorf("password and password_hash are both set")
+	case su.Password != "":
+		hash, err := HashPassword(su.Password)
+		if err != nil {
+			return nil, fmt.Errorf("unable to hash password: %w", err)
+		}
+		u.Password = hash
+	case su.PasswordHash != "":
+		if _, err := bcrypt.Cost([]byte(su.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("invalid password_hash: %w", err)
+		}
+	}
+	return u, nil
+}
+
+// Disconnect does nothing, as there is no connection to close.
+func (m *Memory) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// Ping does nothing, as there is no connection to verify.
+func (m *Memory) Ping(ctx context.Context) error {
+	return nil
+}
+
+// copyUser returns a copy of the given user, so callers
+// cannot change the stored user without updating it.
+func copyUser(u *User) *User {
+	c := *u
+	if u.Roles != nil {
+		c.Roles = append([]string{}, u.Roles...)
+	}
+	if u.WebAuthnCredentials != nil {
+		c.WebAuthnCredentials = append([]webauthn.Credential{}, u.WebAuthnCredentials...)
+	}
+	if u.LockedUntil != nil {
+		t := *u.LockedUntil
+		c.LockedUntil = &t
+	}
+	return &c
+}
+
+// findByUsernameOrMail returns the stored user which has the given
+// string as username or mail address or nil if there is none.
+// The caller needs to hold the lock.
+func (m *Memory) findByUsernameOrMail(s string) *User {
+	if s == "" {
+		return nil
+	}
+	for _, u := range m.users {
+		if u.Username == s || u.Mail == s {
+			return u
+		}
+	}
+	return nil
+}
+
+// find returns the stored user with the given id.
+// The caller needs to hold the lock.
+func (m *Memory) find(id string) (*User, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	u, ok := m.users[oid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return u, nil
+}
+
+// FindUserByUsernameOrMail searches for a user which has the given string as username or mail address.
+func (m *Memory) FindUserByUsernameOrMail(ctx context.Context, s string) (*User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u := m.findByUsernameOrMail(s)
+	if u == nil {
+		return nil, fmt.Errorf("%w: '%s'", ErrUserNotFound, s)
+	}
+	return copyUser(u), nil
+}
+
+// FindUserByID searches for a user with the given id.
+func (m *Memory) FindUserByID(ctx context.Context, id string) (*User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, err := m.find(id)
+	if err != nil {
+		return nil, err
+	}
+	return copyUser(u), nil
+}
+
+// FindUsers returns the users ordered by id.
+// It skips the given number of users and returns at most limit users.
+func (m *Memory) FindUsers(ctx context.Context, skip int, limit int) ([]User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ids := make([]primitive.ObjectID, 0, len(m.users))
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+	users := []User{}
+	for i := skip; i < len(ids) && (limit <= 0 || len(users) < limit); i++ {
+		users = append(users, *copyUser(m.users[ids[i]]))
+	}
+	return users, nil
+}
+
+// CreateUser inserts the given user and sets its id.
+func (m *Memory) CreateUser(ctx context.Context, u *User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.checkUnique(primitive.NilObjectID, u); err != nil {
+		return err
+	}
+	u.ID = primitive.NewObjectID()
+	m.users[u.ID] = copyUser(u)
+	return nil
+}
+
+// checkUnique returns ErrConflict if another user than the one
+// with the given id has the same username or mail address,
+// like the unique indexes of the sql databases.
+func (m *Memory) checkUnique(id primitive.ObjectID, u *User) error {
+	for _, other := range m.users {
+		if other.ID == id {
+			continue
+		}
+		if (u.Username != "" && other.Username == u.Username) || (u.Mail != "" && other.Mail == u.Mail) {
+			return fmt.Errorf("username or mail is %w", ErrConflict)
+		}
+	}
+	return nil
+}
+
+// UpdateUser saves the profile of the given user, which consists of the
+// username, name, mail address, roles, disabled and lock fields.
+// The credentials are not changed.
+func (m *Memory) UpdateUser(ctx context.Context, u *User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.users[u.ID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, u.ID.Hex())
+	}
+	if err := m.checkUnique(u.ID, u); err != nil {
+		return err
+	}
+	c := copyUser(u)
+	stored.Username = c.Username
+	stored.Name = c.Name
+	stored.Mail = c.Mail
+	stored.MailVerified = c.MailVerified
+	stored.Roles = c.Roles
+	stored.Disabled = c.Disabled
+	stored.LockedUntil = c.LockedUntil
+	stored.LockReason = c.LockReason
+	return nil
+}
+
+// UpdateRoles replaces the roles of the user with the given id.
+func (m *Memory) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	u.Roles = append([]string{}, roles...)
+	return nil
+}
+
+// UpdateDisabled disables or enables the user with the given id.
+// The reason is shown to the user and removed when enabling the user.
+func (m *Memory) UpdateDisabled(ctx context.Context, id string, disabled bool, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	u.Disabled = disabled
+	if !disabled {
+		u.LockReason = ""
+	} else if reason != "" {
+		u.LockReason = reason
+	}
+	return nil
+}
+
+// DeleteUser removes the user with the given id.
+func (m *Memory) DeleteUser(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	delete(m.users, u.ID)
+	return nil
+}
+
+// UpdateTOTPSecret sets the totp secret of the user with the given id.
+// An empty secret removes the enrolled totp device.
+func (m *Memory) UpdateTOTPSecret(ctx context.Context, id string, secret string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	u.TOTPSecret = secret
+	return nil
+}
+
+// UpdateTOTPStep stores the time step of the accepted one-time password
+// of the user with the given id. It returns ErrTOTPReplayed if a
+// password of the same or a later step was accepted before.
+func (m *Memory) UpdateTOTPStep(ctx context.Context, id string, step int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	if step <= u.TOTPStep {
+		return ErrTOTPReplayed
+	}
+	u.TOTPStep = step
+	return nil
+}
+
+// UpdateWebAuthnCredentials replaces the registered webauthn
+// credentials of the user with the given id.
+func (m *Memory) UpdateWebAuthnCredentials(ctx context.Context, id string, credentials []webauthn.Credential) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	u.WebAuthnCredentials = append([]webauthn.Credential{}, credentials...)
+	return nil
+}
+
+// UpdatePassword sets the bcrypt password hash of the user with the given id.
+func (m *Memory) UpdatePassword(ctx context.Context, id string, hash string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	u.Password = hash
+	return nil
+}
+
+// FindLoginThrottle searches for the failed login attempts with the given key.
+// If there were no failed attempts, an empty throttle is returned.
+func (m *Memory) FindLoginThrottle(ctx context.Context, key string) (*LoginThrottle, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	t, ok := m.throttles[key]
+	if !ok {
+		return &LoginThrottle{Key: key}, nil
+	}
+	c := *t
+	return &c, nil
+}
+
+// FindLoginThrottles returns all failed login attempts,
+// the most recent ones first.
+func (m *Memory) FindLoginThrottles(ctx context.Context) ([]LoginThrottle, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var data []LoginThrottle
+	for _, t := range m.throttles {
+		data = append(data, *t)
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].LastFailure.After(data[j].LastFailure)
+	})
+	return data, nil
+}
+
+// IncrementLoginFailures registers a failed login attempt
+// for the given key and returns the updated throttle.
+// Expired throttles of all keys are removed.
+func (m *Memory) IncrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, t := range m.throttles {
+		if t.Expired() {
+			delete(m.throttles, k)
+		}
+	}
+	t, ok := m.throttles[key]
+	if !ok {
+		t = &LoginThrottle{Key: key}
+		m.throttles[key] = t
+	}
+	t.Failures++
+	t.LastFailure = time.Now()
+	c := *t
+	return &c, nil
+}
+
+// DecrementLoginFailures removes a registered login attempt
+// for the given key, which did not fail, and returns the updated throttle.
+// If there were no failed attempts, an empty throttle is returned.
+func (m *Memory) DecrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.throttles[key]
+	if !ok {
+		return &LoginThrottle{Key: key}, nil
+	}
+	if t.Failures > 0 {
+		t.Failures--
+	}
+	c := *t
+	return &c, nil
+}
+
+// BlockLogin blocks logins for the given key until the given time.
+func (m *Memory) BlockLogin(ctx context.Context, key string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.throttles[key]
+	if !ok {
+		return fmt.Errorf("unable to find login throttle: %s", key)
+	}
+	t.BlockedUntil = until
+	return nil
+}
+
+// DeleteLoginThrottle removes all failed login attempts for the given key,
+// which also lifts any block.
+func (m *Memory) DeleteLoginThrottle(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.throttles, key)
+	return nil
+}
+
+// CreatePasswordReset stores the given password reset request.
+func (m *Memory) CreatePasswordReset(ctx context.Context, reset *PasswordReset) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.resets[reset.ID]; ok {
+		return fmt.Errorf("password reset already exists: %s", reset.ID)
+	}
+	c := *reset
+	m.resets[reset.ID] = &c
+	return nil
+}
+
+// FindPasswordReset searches for the password reset request with the given id.
+func (m *Memory) FindPasswordReset(ctx context.Context, id string) (*PasswordReset, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	r, ok := m.resets[id]
+	if !ok {
+		return nil, fmt.Errorf("unable to find password reset: %s", id)
+	}
+	c := *r
+	return &c, nil
+}
+
+// DeletePasswordReset removes the password reset request with the given id.
+// An error is returned if the request does not exist (anymore),
+// which ensures that each request is used only once.
+func (m *Memory) DeletePasswordReset(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.resets[id]; !ok {
+		return fmt.Errorf("unable to find password reset: %s", id)
+	}
+	delete(m.resets, id)
+	return nil
+}
+
+// DeletePasswordResets removes all password reset
+// requests of the user with the given id.
+func (m *Memory) DeletePasswordResets(ctx context.Context, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, r := range m.resets {
+		if r.UserID == userID {
+			delete(m.resets, id)
+		}
+	}
+	return nil
+}
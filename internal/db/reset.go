@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PasswordReset represents a pending password reset request.
+type PasswordReset struct {
+	// ID is the hash of the token sent to the user.
+	ID     string `bson:"_id"`
+	UserID string `bson:"user_id"`
+	// Challenge is the hydra login challenge
+	// the user should be returned to.
+	Challenge string    `bson:"challenge,omitempty"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// Expired returns true if the password reset request has expired.
+func (r *PasswordReset) Expired() bool {
+	return time.Now().After(r.ExpiresAt)
+}
+
+// CreatePasswordReset stores the given password reset request.
+func (m *MGO) CreatePasswordReset(ctx context.Context, reset *PasswordReset) error {
+	resetCol, err := m.resets()
+	if err != nil {
+		return err
+	}
+	_, err = resetCol.InsertOne(ctx, reset)
+	return err
+}
+
+// FindPasswordReset searches for the password reset request with the given id.
+func (m *MGO) FindPasswordReset(ctx context.Context, id string) (*PasswordReset, error) {
+	resetCol, err := m.resets()
+	if err != nil {
+		return nil, err
+	}
+	data := &PasswordReset{}
+	res := resetCol.FindOne(ctx, bson.M{"_id": id})
+	err = res.Decode(data)
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("unable to find password reset: %s", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DeletePasswordReset removes the password reset request with the given id.
+// An error is returned if the request does not exist (anymore),
+// which ensures that each request is used only once.
+func (m *MGO) DeletePasswordReset(ctx context.Context, id string) error {
+	resetCol, err := m.resets()
+	if err != nil {
+		return err
+	}
+	res, err := resetCol.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("unable to find password reset: %s", id)
+	}
+	return nil
+}
+
+// DeletePasswordResets removes all password reset
+// requests of the user with the given id.
+func (m *MGO) DeletePasswordResets(ctx context.Context, userID string) error {
+	resetCol, err := m.resets()
+	if err != nil {
+		return err
+	}
+	_, err = resetCol.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -0,0 +1,601 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rbicker/godra/internal/nogo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	// register the database/sql drivers
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// SQL implements the database interface, representing
+// a connection to a sqlite or postgresql database.
+type SQL struct {
+	driver string
+	dsn    string
+	db     *sql.DB
+}
+
+var _ Database = &SQL{}
+
+// the database/sql driver names of the supported drivers.
+var sqlDrivers = map[string]string{
+	"sqlite":   "sqlite",
+	"postgres": "pgx",
+}
+
+// key of the postgresql advisory lock,
+// which serializes the migrations of multiple replicas.
+const migrationLockKey = 7305962841
+
+// NewSQLConnection creates a new sql database connection.
+// It takes functional parameters to change default options
+// such as the driver and the data source name.
+// It returns the newly created connection or an error if
+// something went wrong.
+func NewSQLConnection(opts ...func(*SQL) error) (Database, error) {
+	s := &SQL{
+		driver: "sqlite",
+		dsn:    "file:godra.db?_pragma=busy_timeout(5000)",
+	}
+	for _, op := range opts {
+		err := op(s)
+		if err != nil {
+			return nil, fmt.Errorf("setting option failed: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// SetSQLDriver sets the sql driver, which can be "sqlite" or "postgres".
+func SetSQLDriver(driver string) func(*SQL) error {
+	return func(s *SQL) error {
+		if _, ok := sqlDrivers[driver]; !ok {
+			return fmt.Errorf("unknown sql driver '%s', expected sqlite or postgres", driver)
+		}
+		s.driver = driver
+		return nil
+	}
+}
+
+// SetDSN sets the data source name, such as the path of the sqlite
+// database file or the connection string of the postgresql database.
+func SetDSN(dsn string) func(*SQL) error {
+	return func(s *SQL) error {
+		if dsn == "" {
+			return fmt.Errorf("empty data source name given")
+		}
+		s.dsn = dsn
+		return nil
+	}
+}
+
+// Connect opens the database and applies the pending migrations.
+func (s *SQL) Connect(ctx context.Context) error {
+	db, err := sql.Open(sqlDrivers[s.driver], s.dsn)
+	if err != nil {
+		return err
+	}
+	if s.driver == "sqlite" {
+		// sqlite only supports a single writer, and in-memory
+		// databases are not shared between connections
+		db.SetMaxOpenConns(1)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+	if err = db.PingContext(ctx); err != nil {
+		db.Close()
+		return err
+	}
+	s.db = db
+	if err = s.migrate(ctx); err != nil {
+		db.Close()
+		return fmt.Errorf("migrating database failed: %w", err)
+	}
+	return nil
+}
+
+// Disconnect closes the database.
+func (s *SQL) Disconnect(ctx context.Context) error {
+	if s.db == nil {
+		return ErrNotConnected
+	}
+	return s.db.Close()
+}
+
+// Ping verifies that the database is reachable.
+func (s *SQL) Ping(ctx context.Context) error {
+	if s.db == nil {
+		return ErrNotConnected
+	}
+	return s.db.PingContext(ctx)
+}
+
+// migrate applies the migrations from the assets directory of the
+// configured driver, which have not been applied yet, in order.
+func (s *SQL) migrate(ctx context.Context) error {
+	dir := path.Join("/assets/migrations", s.driver)
+	d, err := nogo.Get(dir)
+	if err != nil {
+		return fmt.Errorf("unable to open migrations: %w", err)
+	}
+	var names []string
+	for _, info := range d.DirInfos {
+		if !info.IsDir() && strings.HasSuffix(info.Name(), ".sql") {
+			names = append(names, info.Name())
+		}
+	}
+	sort.Strings(names)
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if s.driver == "postgres" {
+		if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+			return err
+		}
+		defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey)
+	}
+	_, err = conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS schema_migrations (version TEXT PRIMARY KEY)")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		version := strings.TrimSuffix(name, ".sql")
+		var n int
+		err = conn.QueryRowContext(ctx, s.rebind("SELECT COUNT(*) FROM schema_migrations WHERE version = ?"), version).Scan(&n)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			continue
+		}
+		f, err := nogo.Get(path.Join(dir, name))
+		if err != nil {
+			return fmt.Errorf("unable to open migration %s: %w", name, err)
+		}
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, string(f.Content)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %s failed: %w", name, err)
+		}
+		if _, err = tx.ExecContext(ctx, s.rebind("INSERT INTO schema_migrations (version) VALUES (?)"), version); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err = tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebind replaces the ? placeholders of the given
+// query with $1, $2 ... when using postgresql.
+func (s *SQL) rebind(query string) string {
+	if s.driver != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (s *SQL) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return s.db.ExecContext(ctx, s.rebind(query), args...)
+}
+
+// execUser executes the given query, which updates the user with
+// the given id, and returns an error if the user does not exist.
+func (s *SQL) execUser(ctx context.Context, id string, query string, args ...interface{}) error {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	res, err := s.exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// uniqueUserError returns ErrConflict if the given error is caused
+// by the unique indexes on the username and mail columns.
+// Other errors are returned unchanged.
+func uniqueUserError(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return fmt.Errorf("username or mail is %w", ErrConflict)
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return fmt.Errorf("username or mail is %w", ErrConflict)
+	}
+	return err
+}
+
+// the columns of the users table, in the order scanned by scanUser.
+const userColumns = "id, username, name, mail, mail_verified, password, roles, totp_secret, totp_step, webauthn_credentials, disabled, locked_until, lock_reason"
+
+// scanner is implemented by sql.Row and sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUser reads a user selected using userColumns.
+func scanUser(row scanner) (*User, error) {
+	var (
+		u           User
+		id          string
+		username    sql.NullString
+		roles       string
+		credentials string
+		lockedUntil sql.NullTime
+	)
+	err := row.Scan(&id, &username, &u.Name, &u.Mail, &u.MailVerified, &u.Password, &roles,
+		&u.TOTPSecret, &u.TOTPStep, &credentials, &u.Disabled, &lockedUntil, &u.LockReason)
+	if err != nil {
+		return nil, err
+	}
+	if u.ID, err = primitive.ObjectIDFromHex(id); err != nil {
+		return nil, fmt.Errorf("invalid user id '%s' in database", id)
+	}
+	u.Username = username.String
+	if err = json.Unmarshal([]byte(roles), &u.Roles); err != nil {
+		return nil, fmt.Errorf("invalid roles of user %s in database: %w", id, err)
+	}
+	if err = json.Unmarshal([]byte(credentials), &u.WebAuthnCredentials); err != nil {
+		return nil, fmt.Errorf("invalid webauthn credentials of user %s in database: %w", id, err)
+	}
+	if len(u.WebAuthnCredentials) == 0 {
+		u.WebAuthnCredentials = nil
+	}
+	if lockedUntil.Valid {
+		t := lockedUntil.Time
+		u.LockedUntil = &t
+	}
+	return &u, nil
+}
+
+// nullString returns NULL for empty strings, as usernames are optional.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullTime returns NULL for nil times.
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+// jsonList encodes the given list as json array, encoding nil as empty array.
+func jsonList(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	if string(b) == "null" {
+		return "[]", nil
+	}
+	return string(b), nil
+}
+
+// FindUserByUsernameOrMail searches for a user which has the given string as username or mail address.
+func (s *SQL) FindUserByUsernameOrMail(ctx context.Context, str string) (*User, error) {
+	if str == "" {
+		return nil, fmt.Errorf("%w: '%s'", ErrUserNotFound, str)
+	}
+	row := s.db.QueryRowContext(ctx, s.rebind("SELECT "+userColumns+" FROM users WHERE username = ? OR mail = ? ORDER BY id LIMIT 1"), str, str)
+	u, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: '%s'", ErrUserNotFound, str)
+	}
+	return u, err
+}
+
+// FindUserByID searches for a user with the given id.
+func (s *SQL) FindUserByID(ctx context.Context, id string) (*User, error) {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return nil, fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	row := s.db.QueryRowContext(ctx, s.rebind("SELECT "+userColumns+" FROM users WHERE id = ?"), id)
+	u, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return u, err
+}
+
+// FindUsers returns the users ordered by id.
+// It skips the given number of users and returns at most limit users.
+func (s *SQL) FindUsers(ctx context.Context, skip int, limit int) ([]User, error) {
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind("SELECT "+userColumns+" FROM users ORDER BY id LIMIT ? OFFSET ?"), limit, skip)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := []User{}
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *u)
+	}
+	return users, rows.Err()
+}
+
+// CreateUser inserts the given user and sets its id.
+func (s *SQL) CreateUser(ctx context.Context, u *User) error {
+	roles, err := jsonList(u.Roles)
+	if err != nil {
+		return err
+	}
+	credentials, err := jsonList(u.WebAuthnCredentials)
+	if err != nil {
+		return err
+	}
+	id := primitive.NewObjectID()
+	_, err = s.exec(ctx, "INSERT INTO users ("+userColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		id.Hex(), nullString(u.Username), u.Name, u.Mail, u.MailVerified, u.Password, roles,
+		u.TOTPSecret, u.TOTPStep, credentials, u.Disabled, nullTime(u.LockedUntil), u.LockReason)
+	if err != nil {
+		return uniqueUserError(err)
+	}
+	u.ID = id
+	return nil
+}
+
+// UpdateUser saves the profile of the given user, which consists of the
+// username, name, mail address, roles, disabled and lock fields.
+// The credentials are not changed.
+func (s *SQL) UpdateUser(ctx context.Context, u *User) error {
+	roles, err := jsonList(u.Roles)
+	if err != nil {
+		return err
+	}
+	err = s.execUser(ctx, u.ID.Hex(),
+		"UPDATE users SET username = ?, name = ?, mail = ?, mail_verified = ?, roles = ?, disabled = ?, locked_until = ?, lock_reason = ? WHERE id = ?",
+		nullString(u.Username), u.Name, u.Mail, u.MailVerified, roles, u.Disabled, nullTime(u.LockedUntil), u.LockReason, u.ID.Hex())
+	return uniqueUserError(err)
+}
+
+// UpdateRoles replaces the roles of the user with the given id.
+func (s *SQL) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	r, err := jsonList(roles)
+	if err != nil {
+		return err
+	}
+	return s.execUser(ctx, id, "UPDATE users SET roles = ? WHERE id = ?", r, id)
+}
+
+// UpdateDisabled disables or enables the user with the given id.
+// The reason is shown to the user and removed when enabling the user.
+func (s *SQL) UpdateDisabled(ctx context.Context, id string, disabled bool, reason string) error {
+	if !disabled {
+		return s.execUser(ctx, id, "UPDATE users SET disabled = ?, lock_reason = '' WHERE id = ?", false, id)
+	}
+	if reason == "" {
+		return s.execUser(ctx, id, "UPDATE users SET disabled = ? WHERE id = ?", true, id)
+	}
+	return s.execUser(ctx, id, "UPDATE users SET disabled = ?, lock_reason = ? WHERE id = ?", true, reason, id)
+}
+
+// DeleteUser removes the user with the given id.
+func (s *SQL) DeleteUser(ctx context.Context, id string) error {
+	return s.execUser(ctx, id, "DELETE FROM users WHERE id = ?", id)
+}
+
+// UpdateTOTPSecret sets the totp secret of the user with the given id.
+// An empty secret removes the enrolled totp device.
+func (s *SQL) UpdateTOTPSecret(ctx context.Context, id string, secret string) error {
+	return s.execUser(ctx, id, "UPDATE users SET totp_secret = ? WHERE id = ?", secret, id)
+}
+
+// UpdateTOTPStep stores the time step of the accepted one-time password
+// of the user with the given id. It returns ErrTOTPReplayed if a
+// password of the same or a later step was accepted before.
+func (s *SQL) UpdateTOTPStep(ctx context.Context, id string, step int64) error {
+	// only update if the stored step is older, so concurrent
+	// requests cannot both use the same password
+	err := s.execUser(ctx, id, "UPDATE users SET totp_step = ? WHERE id = ? AND totp_step < ?", step, id, step)
+	if errors.Is(err, ErrUserNotFound) {
+		if _, err = s.FindUserByID(ctx, id); err != nil {
+			return err
+		}
+		return ErrTOTPReplayed
+	}
+	return err
+}
+
+// UpdateWebAuthnCredentials replaces the registered webauthn
+// credentials of the user with the given id.
+func (s *SQL) UpdateWebAuthnCredentials(ctx context.Context, id string, credentials []webauthn.Credential) error {
+	c, err := jsonList(credentials)
+	if err != nil {
+		return err
+	}
+	return s.execUser(ctx, id, "UPDATE users SET webauthn_credentials = ? WHERE id = ?", c, id)
+}
+
+// UpdatePassword sets the bcrypt password hash of the user with the given id.
+func (s *SQL) UpdatePassword(ctx context.Context, id string, hash string) error {
+	return s.execUser(ctx, id, "UPDATE users SET password = ? WHERE id = ?", hash, id)
+}
+
+// the columns of the login_throttles table, in the order scanned by scanLoginThrottle.
+const throttleColumns = "throttle_key, failures, last_failure, blocked_until"
+
+// scanLoginThrottle reads a throttle selected using throttleColumns.
+func scanLoginThrottle(row scanner) (*LoginThrottle, error) {
+	var t LoginThrottle
+	if err := row.Scan(&t.Key, &t.Failures, &t.LastFailure, &t.BlockedUntil); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// FindLoginThrottle searches for the failed login attempts with the given key.
+// If there were no failed attempts, an empty throttle is returned.
+func (s *SQL) FindLoginThrottle(ctx context.Context, key string) (*LoginThrottle, error) {
+	row := s.db.QueryRowContext(ctx, s.rebind("SELECT "+throttleColumns+" FROM login_throttles WHERE throttle_key = ?"), key)
+	t, err := scanLoginThrottle(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &LoginThrottle{Key: key}, nil
+	}
+	return t, err
+}
+
+// FindLoginThrottles returns all failed login attempts,
+// the most recent ones first.
+func (s *SQL) FindLoginThrottles(ctx context.Context) ([]LoginThrottle, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+throttleColumns+" FROM login_throttles ORDER BY last_failure DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var data []LoginThrottle
+	for rows.Next() {
+		t, err := scanLoginThrottle(rows)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, *t)
+	}
+	return data, rows.Err()
+}
+
+// IncrementLoginFailures registers a failed login attempt
+// for the given key and returns the updated throttle.
+// Expired throttles of all keys are removed.
+func (s *SQL) IncrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	expired := time.Now().Add(-LoginThrottleTTL).UTC()
+	_, err = tx.ExecContext(ctx, s.rebind("DELETE FROM login_throttles WHERE last_failure < ? AND blocked_until < ?"), expired, expired)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, s.rebind(`INSERT INTO login_throttles (`+throttleColumns+`) VALUES (?, 1, ?, ?)
+		ON CONFLICT (throttle_key) DO UPDATE SET failures = login_throttles.failures + 1, last_failure = excluded.last_failure`),
+		key, time.Now().UTC(), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	row := tx.QueryRowContext(ctx, s.rebind("SELECT "+throttleColumns+" FROM login_throttles WHERE throttle_key = ?"), key)
+	t, err := scanLoginThrottle(row)
+	if err != nil {
+		return nil, err
+	}
+	return t, tx.Commit()
+}
+
+// DecrementLoginFailures removes a registered login attempt
+// for the given key, which did not fail, and returns the updated throttle.
+// If there were no failed attempts, an empty throttle is returned.
+func (s *SQL) DecrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	_, err := s.exec(ctx, "UPDATE login_throttles SET failures = failures - 1 WHERE throttle_key = ? AND failures > 0", key)
+	if err != nil {
+		return nil, err
+	}
+	return s.FindLoginThrottle(ctx, key)
+}
+
+// BlockLogin blocks logins for the given key until the given time.
+func (s *SQL) BlockLogin(ctx context.Context, key string, until time.Time) error {
+	res, err := s.exec(ctx, "UPDATE login_throttles SET blocked_until = ? WHERE throttle_key = ?", until.UTC(), key)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("unable to find login throttle: %s", key)
+	}
+	return nil
+}
+
+// DeleteLoginThrottle removes all failed login attempts for the given key,
+// which also lifts any block.
+func (s *SQL) DeleteLoginThrottle(ctx context.Context, key string) error {
+	_, err := s.exec(ctx, "DELETE FROM login_throttles WHERE throttle_key = ?", key)
+	return err
+}
+
+// CreatePasswordReset stores the given password reset request.
+func (s *SQL) CreatePasswordReset(ctx context.Context, reset *PasswordReset) error {
+	_, err := s.exec(ctx, "INSERT INTO password_resets (id, user_id, challenge, expires_at) VALUES (?, ?, ?, ?)",
+		reset.ID, reset.UserID, reset.Challenge, reset.ExpiresAt.UTC())
+	return err
+}
+
+// FindPasswordReset searches for the password reset request with the given id.
+func (s *SQL) FindPasswordReset(ctx context.Context, id string) (*PasswordReset, error) {
+	var r PasswordReset
+	row := s.db.QueryRowContext(ctx, s.rebind("SELECT id, user_id, challenge, expires_at FROM password_resets WHERE id = ?"), id)
+	err := row.Scan(&r.ID, &r.UserID, &r.Challenge, &r.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("unable to find password reset: %s", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeletePasswordReset removes the password reset request with the given id.
+// An error is returned if the request does not exist (anymore),
+// which ensures that each request is used only once.
+func (s *SQL) DeletePasswordReset(ctx context.Context, id string) error {
+	res, err := s.exec(ctx, "DELETE FROM password_resets WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("unable to find password reset: %s", id)
+	}
+	return nil
+}
+
+// DeletePasswordResets removes all password reset
+// requests of the user with the given id.
+func (s *SQL) DeletePasswordResets(ctx context.Context, userID string) error {
+	_, err := s.exec(ctx, "DELETE FROM password_resets WHERE user_id = ?", userID)
+	return err
+}
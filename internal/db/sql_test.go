@@ -0,0 +1,21 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+// newSQLiteDatabase creates a migrated sqlite database in a temporary directory.
+func newSQLiteDatabase(t *testing.T) Database {
+	t.Helper()
+	d, err := NewSQLConnection(SetDSN("file:" + filepath.Join(t.TempDir(), "godra.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Connect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.Disconnect(context.Background()) })
+	return d
+}
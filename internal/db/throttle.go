@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LoginThrottle represents the failed login attempts for a key,
+// such as a user or a client ip address.
+type LoginThrottle struct {
+	Key          string    `bson:"_id"`
+	Failures     int       `bson:"failures"`
+	LastFailure  time.Time `bson:"last_failure"`
+	BlockedUntil time.Time `bson:"blocked_until"`
+}
+
+// LoginThrottleTTL is the duration after which failed login attempts
+// are removed from the database, counted from the last failure or
+// the end of the block, whichever is later.
+const LoginThrottleTTL = 24 * time.Hour
+
+// Blocked returns true if logins for the throttle's key are blocked at the moment.
+func (t *LoginThrottle) Blocked() bool {
+	return time.Now().Before(t.BlockedUntil)
+}
+
+// Expired returns true if the throttle can be removed
+// because LoginThrottleTTL passed since the last failure
+// and the end of the block.
+func (t *LoginThrottle) Expired() bool {
+	end := t.LastFailure
+	if t.BlockedUntil.After(end) {
+		end = t.BlockedUntil
+	}
+	return time.Since(end) > LoginThrottleTTL
+}
+
+// FindLoginThrottle searches for the failed login attempts with the given key.
+// If there were no failed attempts, an empty throttle is returned.
+func (m *MGO) FindLoginThrottle(ctx context.Context, key string) (*LoginThrottle, error) {
+	throttleCol, err := m.throttles()
+	if err != nil {
+		return nil, err
+	}
+	data := &LoginThrottle{}
+	res := throttleCol.FindOne(ctx, bson.M{"_id": key})
+	err = res.Decode(data)
+	if err == mongo.ErrNoDocuments {
+		return &LoginThrottle{Key: key}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// FindLoginThrottles returns all failed login attempts,
+// the most recent ones first.
+func (m *MGO) FindLoginThrottles(ctx context.Context) ([]LoginThrottle, error) {
+	throttleCol, err := m.throttles()
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.M{"last_failure": -1})
+	cur, err := throttleCol.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var data []LoginThrottle
+	if err = cur.All(ctx, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// IncrementLoginFailures registers a failed login attempt
+// for the given key and returns the updated throttle.
+// The throttle is removed by a ttl index on expires_at
+// once LoginThrottleTTL passed.
+func (m *MGO) IncrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	throttleCol, err := m.throttles()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	update := bson.M{
+		"$inc":         bson.M{"failures": 1},
+		"$set":         bson.M{"last_failure": now},
+		"$max":         bson.M{"expires_at": now.Add(LoginThrottleTTL)},
+		"$setOnInsert": bson.M{"blocked_until": time.Time{}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	data := &LoginThrottle{}
+	res := throttleCol.FindOneAndUpdate(ctx, bson.M{"_id": key}, update, opts)
+	if err := res.Decode(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DecrementLoginFailures removes a registered login attempt
+// for the given key, which did not fail, and returns the updated throttle.
+// If there were no failed attempts, an empty throttle is returned.
+func (m *MGO) DecrementLoginFailures(ctx context.Context, key string) (*LoginThrottle, error) {
+	throttleCol, err := m.throttles()
+	if err != nil {
+		return nil, err
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	data := &LoginThrottle{}
+	res := throttleCol.FindOneAndUpdate(ctx, bson.M{"_id": key, "failures": bson.M{"$gt": 0}}, bson.M{"$inc": bson.M{"failures": -1}}, opts)
+	err = res.Decode(data)
+	if err == mongo.ErrNoDocuments {
+		return m.FindLoginThrottle(ctx, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// BlockLogin blocks logins for the given key until the given time.
+func (m *MGO) BlockLogin(ctx context.Context, key string, until time.Time) error {
+	throttleCol, err := m.throttles()
+	if err != nil {
+		return err
+	}
+	update := bson.M{
+		"$set": bson.M{"blocked_until": until},
+		"$max": bson.M{"expires_at": until.Add(LoginThrottleTTL)},
+	}
+	res, err := throttleCol.UpdateOne(ctx, bson.M{"_id": key}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("unable to find login throttle: %s", key)
+	}
+	return nil
+}
+
+// DeleteLoginThrottle removes all failed login attempts for the given key,
+// which also lifts any block.
+func (m *MGO) DeleteLoginThrottle(ctx context.Context, key string) error {
+	throttleCol, err := m.throttles()
+	if err != nil {
+		return err
+	}
+	_, err = throttleCol.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
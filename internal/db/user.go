@@ -2,33 +2,199 @@ package db
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// MinPasswordLength is the minimum length of new passwords.
+const MinPasswordLength = 8
+
 // User represents a user document.
 type User struct {
 	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	Username string             `bson:"username,omitempty"`
 	Mail     string             `bson:"mail"`
 	Password string             `bson:"password"`
 	Roles    []string           `bson:"roles"`
+	// Name is the user's full name.
+	Name string `bson:"name,omitempty"`
+	// MailVerified is true if the user's mail address was verified.
+	MailVerified bool `bson:"mail_verified,omitempty"`
+	// TOTPSecret is the base32 encoded secret used to
+	// validate time-based one-time passwords (RFC 6238).
+	TOTPSecret string `bson:"totp_secret,omitempty"`
+	// TOTPStep is the time step of the last accepted one-time
+	// password, so a code cannot be used a second time.
+	TOTPStep int64 `bson:"totp_step,omitempty"`
+	// WebAuthnCredentials contains the registered
+	// webauthn authenticators, such as passkeys.
+	WebAuthnCredentials []webauthn.Credential `bson:"webauthn_credentials,omitempty"`
+	// Disabled prevents the user from logging in.
+	Disabled bool `bson:"disabled,omitempty"`
+	// LockedUntil prevents the user from logging in
+	// until the given time has passed.
+	LockedUntil *time.Time `bson:"locked_until,omitempty"`
+	// LockReason describes why the user was disabled or locked.
+	LockReason string `bson:"lock_reason,omitempty"`
+	// ExternalID is the id of users from an external directory,
+	// such as ldap. It is used instead of the object id.
+	ExternalID string `bson:"-"`
 }
 
-// ValidatePassword validates the given plaintext password for the user
-func (u *User) ValidatePassword(plainPassword string) error {
-	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(plainPassword))
+// PasswordValidator is implemented by databases which validate passwords
+// themselves, such as ldap, instead of comparing the stored hash.
+type PasswordValidator interface {
+	ValidatePassword(ctx context.Context, u *User, plainPassword string) error
+}
+
+// ErrTOTPReplayed is returned if a one-time password of the
+// same or a later time step has already been accepted.
+var ErrTOTPReplayed = errors.New("one-time password was already used")
+
+// totpPeriod is the number of seconds a one-time password is valid for.
+const totpPeriod = 30
+
+// ErrInvalidCredentials is returned if a password is wrong.
+// Other errors while validating a password, such as an unreachable
+// ldap server, do not wrap it.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// ErrConflict is returned if a username or mail address
+// is already used by another user.
+var ErrConflict = errors.New("already in use")
+
+// ErrReadOnly is returned by databases which do not support changing users.
+var ErrReadOnly = errors.New("users are read-only")
+
+// ReadOnlyDatabase is implemented by databases which do not support
+// changing users, such as ldap. Their methods changing users return ErrReadOnly.
+type ReadOnlyDatabase interface {
+	ReadOnly() bool
+}
+
+// IsReadOnly returns true if the users of the given database cannot be changed.
+func IsReadOnly(d Database) bool {
+	r, ok := d.(ReadOnlyDatabase)
+	return ok && r.ReadOnly()
+}
+
+// HashPassword creates the bcrypt hash of the given plaintext password.
+func HashPassword(plainPassword string) (string, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(plainPassword), bcrypt.DefaultCost)
 	if err != nil {
-		return err
+		return "", err
+	}
+	return string(b), nil
+}
+
+// CheckUnique returns an error if the given username or mail address
+// is already used by a user other than the one with the given id.
+// As both are used to log in, they need to be unique across both fields.
+func CheckUnique(ctx context.Context, d Database, id primitive.ObjectID, username string, mail string) error {
+	for _, s := range []string{username, mail} {
+		if s == "" {
+			continue
+		}
+		other, err := d.FindUserByUsernameOrMail(ctx, s)
+		if errors.Is(err, ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if other.ID != id {
+			return fmt.Errorf("username or mail '%s' is %w", s, ErrConflict)
+		}
 	}
 	return nil
 }
 
+// Subject returns the id of the user, which is used as subject in hydra.
+func (u *User) Subject() string {
+	if u.ExternalID != "" {
+		return u.ExternalID
+	}
+	return u.ID.Hex()
+}
+
+// CheckPassword validates the given plaintext password for the user,
+// using the database if it implements the PasswordValidator interface.
+// Only an error wrapping ErrInvalidCredentials means that the password
+// is wrong.
+func CheckPassword(ctx context.Context, d Database, u *User, plainPassword string) error {
+	if v, ok := d.(PasswordValidator); ok {
+		return v.ValidatePassword(ctx, u, plainPassword)
+	}
+	return u.ValidatePassword(plainPassword)
+}
+
+// ValidatePassword validates the given plaintext password for the user.
+// An error wrapping ErrInvalidCredentials is returned if the password
+// is wrong or the user does not have a password.
+func (u *User) ValidatePassword(plainPassword string) error {
+	if u.Password == "" {
+		return fmt.Errorf("%w: user has no password", ErrInvalidCredentials)
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(plainPassword))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return err
+}
+
+// Locked returns true if the user is locked at the moment.
+func (u *User) Locked() bool {
+	return u.LockedUntil != nil && time.Now().Before(*u.LockedUntil)
+}
+
+// TOTPEnabled returns true if the user has enrolled a totp device.
+func (u *User) TOTPEnabled() bool {
+	return u.TOTPSecret != ""
+}
+
+// ValidateTOTP validates the given time-based one-time password for
+// the user and returns its time step. Codes of the previous and the
+// next time step are accepted as well, to allow for clock skew.
+func (u *User) ValidateTOTP(code string) (int64, error) {
+	return u.validateTOTPAt(code, time.Now())
+}
+
+// validateTOTPAt validates the one-time password at the given time.
+func (u *User) validateTOTPAt(code string, t time.Time) (int64, error) {
+	if !u.TOTPEnabled() {
+		return 0, fmt.Errorf("no totp device enrolled")
+	}
+	opts := totp.ValidateOpts{Period: totpPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1}
+	step := t.Unix() / totpPeriod
+	for _, s := range []int64{step - 1, step, step + 1} {
+		expected, err := totp.GenerateCodeCustom(u.TOTPSecret, time.Unix(s*totpPeriod, 0), opts)
+		if err != nil {
+			return 0, fmt.Errorf("invalid totp secret: %w", err)
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid totp code")
+}
+
 // FindUserByUsernameOrMail searches for a user which has the given string as username or mail address.
-func (MGO) FindUserByUsernameOrMail(s string) (*User, error) {
+func (m *MGO) FindUserByUsernameOrMail(ctx context.Context, s string) (*User, error) {
+	col, err := m.users()
+	if err != nil {
+		return nil, err
+	}
 	data := &User{}
 	filter := bson.M{
 		"$or": []bson.M{
@@ -36,10 +202,10 @@ func (MGO) FindUserByUsernameOrMail(s string) (*User, error) {
 			{"mail": s},
 		},
 	}
-	res := col.FindOne(context.Background(), filter)
-	err := res.Decode(data)
+	res := col.FindOne(ctx, filter)
+	err = res.Decode(data)
 	if err == mongo.ErrNoDocuments {
-		return nil, fmt.Errorf("unable to find user '%s'", s)
+		return nil, fmt.Errorf("%w: '%s'", ErrUserNotFound, s)
 	}
 	if err != nil {
 		return nil, err
@@ -48,20 +214,299 @@ func (MGO) FindUserByUsernameOrMail(s string) (*User, error) {
 }
 
 // FindUserByID searches for a user with the given id.
-func (MGO) FindUserByID(id string) (*User, error) {
+func (m *MGO) FindUserByID(ctx context.Context, id string) (*User, error) {
+	col, err := m.users()
+	if err != nil {
+		return nil, err
+	}
 	oid, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("cannot parse id: %v", id)
+		return nil, fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
 	}
 	data := &User{}
 	filter := bson.M{"_id": oid}
-	res := col.FindOne(context.Background(), filter)
+	res := col.FindOne(ctx, filter)
 	err = res.Decode(data)
 	if err == mongo.ErrNoDocuments {
-		return nil, fmt.Errorf("unable to find user with id: %s", id)
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, id)
 	}
 	if err != nil {
 		return nil, err
 	}
 	return data, nil
 }
+
+// UpdateTOTPSecret sets the totp secret of the user with the given id.
+// An empty secret removes the enrolled totp device.
+func (m *MGO) UpdateTOTPSecret(ctx context.Context, id string, secret string) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	update := bson.M{"$set": bson.M{"totp_secret": secret}}
+	if secret == "" {
+		update = bson.M{"$unset": bson.M{"totp_secret": ""}}
+	}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// UpdateTOTPStep stores the time step of the accepted one-time password
+// of the user with the given id. It returns ErrTOTPReplayed if a
+// password of the same or a later step was accepted before.
+func (m *MGO) UpdateTOTPStep(ctx context.Context, id string, step int64) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	// only update if the stored step is older, so concurrent
+	// requests cannot both use the same password
+	filter := bson.M{
+		"_id": oid,
+		"$or": []bson.M{
+			{"totp_step": bson.M{"$lt": step}},
+			{"totp_step": bson.M{"$exists": false}},
+		},
+	}
+	res, err := col.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"totp_step": step}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		if _, err = m.FindUserByID(ctx, id); err != nil {
+			return err
+		}
+		return ErrTOTPReplayed
+	}
+	return nil
+}
+
+// UpdateWebAuthnCredentials replaces the registered webauthn
+// credentials of the user with the given id.
+func (m *MGO) UpdateWebAuthnCredentials(ctx context.Context, id string, credentials []webauthn.Credential) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	update := bson.M{"$set": bson.M{"webauthn_credentials": credentials}}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// UpdatePassword sets the bcrypt password hash of the user with the given id.
+func (m *MGO) UpdatePassword(ctx context.Context, id string, hash string) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	update := bson.M{"$set": bson.M{"password": hash}}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// FindUsers returns the users ordered by id.
+// It skips the given number of users and returns at most limit users.
+func (m *MGO) FindUsers(ctx context.Context, skip int, limit int) ([]User, error) {
+	col, err := m.users()
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetSkip(int64(skip)).SetLimit(int64(limit))
+	cur, err := col.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	users := []User{}
+	if err = cur.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// CreateUser inserts the given user and sets its id.
+func (m *MGO) CreateUser(ctx context.Context, u *User) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	u.ID = primitive.NewObjectID()
+	_, err = col.InsertOne(ctx, u)
+	return duplicateKeyError(err)
+}
+
+// UpdateUser saves the profile of the given user, which consists of the
+// username, name, mail address, roles, disabled and lock fields.
+// The credentials are not changed.
+func (m *MGO) UpdateUser(ctx context.Context, u *User) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	set := bson.M{"mail": u.Mail, "roles": u.Roles}
+	unset := bson.M{}
+	if u.Username != "" {
+		set["username"] = u.Username
+	} else {
+		unset["username"] = ""
+	}
+	if u.Name != "" {
+		set["name"] = u.Name
+	} else {
+		unset["name"] = ""
+	}
+	if u.MailVerified {
+		set["mail_verified"] = true
+	} else {
+		unset["mail_verified"] = ""
+	}
+	if u.Disabled {
+		set["disabled"] = true
+	} else {
+		unset["disabled"] = ""
+	}
+	if u.LockedUntil != nil {
+		set["locked_until"] = u.LockedUntil
+	} else {
+		unset["locked_until"] = ""
+	}
+	if u.LockReason != "" {
+		set["lock_reason"] = u.LockReason
+	} else {
+		unset["lock_reason"] = ""
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": u.ID}, update)
+	if err != nil {
+		return duplicateKeyError(err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, u.ID.Hex())
+	}
+	return nil
+}
+
+// duplicateKeyError returns ErrConflict if the given error is caused
+// by the unique indexes on the username and mail fields.
+// Other errors are returned unchanged.
+func duplicateKeyError(err error) error {
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) {
+		for _, e := range writeErr.WriteErrors {
+			if e.Code == 11000 {
+				return fmt.Errorf("username or mail is %w", ErrConflict)
+			}
+		}
+	}
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == 11000 {
+		return fmt.Errorf("username or mail is %w", ErrConflict)
+	}
+	return err
+}
+
+// UpdateRoles replaces the roles of the user with the given id.
+func (m *MGO) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	update := bson.M{"$set": bson.M{"roles": roles}}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// UpdateDisabled disables or enables the user with the given id.
+// The reason is shown to the user and removed when enabling the user.
+func (m *MGO) UpdateDisabled(ctx context.Context, id string, disabled bool, reason string) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	update := bson.M{"$unset": bson.M{"disabled": "", "lock_reason": ""}}
+	if disabled {
+		set := bson.M{"disabled": true}
+		if reason != "" {
+			set["lock_reason"] = reason
+		}
+		update = bson.M{"$set": set}
+	}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// DeleteUser removes the user with the given id.
+func (m *MGO) DeleteUser(ctx context.Context, id string) error {
+	col, err := m.users()
+	if err != nil {
+		return err
+	}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: cannot parse id: %v", ErrUserNotFound, id)
+	}
+	res, err := col.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestValidateTOTP(t *testing.T) {
+	const secret = "JBSWY3DPEHPK3PXP"
+	u := &User{TOTPSecret: secret}
+	now := time.Unix(1700000000, 0)
+	step := now.Unix() / totpPeriod
+	code := func(s int64) string {
+		c, err := totp.GenerateCodeCustom(secret, time.Unix(s*totpPeriod, 0), totp.ValidateOpts{
+			Period: totpPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	tests := []struct {
+		name     string
+		code     string
+		wantStep int64
+		wantErr  bool
+	}{
+		{name: "current step", code: code(step), wantStep: step},
+		{name: "previous step", code: code(step - 1), wantStep: step - 1},
+		{name: "next step", code: code(step + 1), wantStep: step + 1},
+		{name: "too old", code: code(step - 2), wantErr: true},
+		{name: "wrong code", code: "abcdef", wantErr: true},
+		{name: "empty code", code: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := u.validateTOTPAt(tt.code, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got step %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.wantStep {
+				t.Fatalf("expected step %v, got %v", tt.wantStep, got)
+			}
+		})
+	}
+	if _, err := (&User{}).validateTOTPAt(code(step), now); err == nil {
+		t.Fatal("expected an error for a user without totp device")
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	hash, err := HashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		wantErr  bool
+		invalid  bool
+	}{
+		{name: "valid", hash: hash, password: "secret-password"},
+		{name: "wrong", hash: hash, password: "wrong-password", wantErr: true, invalid: true},
+		{name: "no password", hash: "", password: "secret-password", wantErr: true, invalid: true},
+		{name: "malformed hash", hash: "$2a$10$short", password: "secret-password", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&User{Password: tt.hash}).ValidatePassword(tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(err, ErrInvalidCredentials) != tt.invalid {
+				t.Fatalf("expected invalid credentials %v, got %v", tt.invalid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package godra
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+// maximum number of users returned by a single list request.
+const maxUsersPerPage = 1000
+
+// adminUser is the representation of a user in the admin api.
+// It does not contain any credentials.
+type adminUser struct {
+	ID           string     `json:"id"`
+	Username     string     `json:"username,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Mail         string     `json:"mail"`
+	MailVerified bool       `json:"mail_verified"`
+	Roles        []string   `json:"roles"`
+	Disabled     bool       `json:"disabled"`
+	LockedUntil  *time.Time `json:"locked_until,omitempty"`
+	LockReason   string     `json:"lock_reason,omitempty"`
+	TOTPEnabled  bool       `json:"totp_enabled"`
+	Passkeys     int        `json:"passkeys"`
+}
+
+// newAdminUser converts the given user to its admin api representation.
+func newAdminUser(u *db.User) adminUser {
+	roles := u.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	return adminUser{
+		ID:           u.Subject(),
+		Username:     u.Username,
+		Name:         u.Name,
+		Mail:         u.Mail,
+		MailVerified: u.MailVerified,
+		Roles:        roles,
+		Disabled:     u.Disabled,
+		LockedUntil:  u.LockedUntil,
+		LockReason:   u.LockReason,
+		TOTPEnabled:  u.TOTPEnabled(),
+		Passkeys:     len(u.WebAuthnCredentials),
+	}
+}
+
+// adminProfileRequest is the body of requests updating a user's profile.
+// Roles, the disabled flag and the password are changed using their own routes.
+type adminProfileRequest struct {
+	Username     string `json:"username"`
+	Name         string `json:"name"`
+	Mail         string `json:"mail"`
+	MailVerified bool   `json:"mail_verified"`
+}
+
+// adminUserRequest is the body of requests creating users.
+type adminUserRequest struct {
+	adminProfileRequest
+	Password    string     `json:"password"`
+	Roles       []string   `json:"roles"`
+	Disabled    bool       `json:"disabled"`
+	LockedUntil *time.Time `json:"locked_until"`
+	LockReason  string     `json:"lock_reason"`
+}
+
+// adminError is the body of error responses.
+type adminError struct {
+	Error string `json:"error"`
+}
+
+// writeAdminError writes an error response with the given status.
+func writeAdminError(w http.ResponseWriter, status int, format string, a ...interface{}) {
+	writeJSON(w, status, adminError{Error: fmt.Sprintf(format, a...)})
+}
+
+// writeAdminUpdateError writes the error response for an error returned
+// by the database while changing a user. Unexpected errors are logged
+// and answered with an internal server error.
+func writeAdminUpdateError(ctx context.Context, w http.ResponseWriter, err error, action string) {
+	switch {
+	case errors.Is(err, db.ErrConflict):
+		writeAdminError(w, http.StatusConflict, "%v", err)
+	case errors.Is(err, db.ErrUserNotFound):
+		writeAdminError(w, http.StatusNotFound, "user not found")
+	case errors.Is(err, db.ErrReadOnly):
+		writeAdminError(w, http.StatusMethodNotAllowed, "the users are read-only")
+	default:
+		slog.ErrorContext(ctx, "error while trying to "+action, "error", err)
+		writeAdminError(w, http.StatusInternalServerError, "unable to %v", action)
+	}
+}
+
+// decodeAdminRequest decodes the json body of an admin
+// request and writes an error response if it is invalid.
+func decodeAdminRequest(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return false
+	}
+	return true
+}
+
+// GetAdminHandler returns the handler for the admin api.
+// All requests need to be authenticated using the admin token
+// as bearer token. The following routes are served:
+//
+//	GET    /users                 list users (query parameters offset and limit)
+//	POST   /users                 create a user
+//	GET    /users/{id}            get a user
+//	PUT    /users/{id}            update a user's profile
+//	DELETE /users/{id}            delete a user
+//	PUT    /users/{id}/password   set a user's password
+//	PUT    /users/{id}/roles      set a user's roles
+//	POST   /users/{id}/disable    disable a user
+//	POST   /users/{id}/enable     enable a user
+//	GET    /throttles             list blocked throttle keys
+//	DELETE /throttles/{key}       clear the failed logins of a throttle key
+func (srv Server) GetAdminHandler() http.Handler {
+	m := http.NewServeMux()
+	m.HandleFunc("/users", srv.handleAdminUsers)
+	m.HandleFunc("/users/", srv.handleAdminUser)
+	m.HandleFunc("/throttles", srv.handleAdminThrottles)
+	m.HandleFunc("/throttles/", srv.handleAdminThrottle)
+	return srv.adminAuth(m)
+}
+
+// adminAuth only passes requests carrying
+// the admin token on to the given handler.
+func (srv Server) adminAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if srv.adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(srv.adminToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeAdminError(w, http.StatusUnauthorized, "invalid or missing admin token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleAdminUsers lists or creates users.
+func (srv Server) handleAdminUsers(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		offset, err := queryInt(r, "offset", 0)
+		if err != nil || offset < 0 {
+			writeAdminError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		limit, err := queryInt(r, "limit", 100)
+		if err != nil || limit <= 0 || limit > maxUsersPerPage {
+			writeAdminError(w, http.StatusBadRequest, "invalid limit, needs to be between 1 and %v", maxUsersPerPage)
+			return
+		}
+		users, err := srv.Database().FindUsers(r.Context(), offset, limit)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while listing users", "error", err)
+			writeAdminError(w, http.StatusInternalServerError, "unable to list users")
+			return
+		}
+		res := make([]adminUser, 0, len(users))
+		for i := range users {
+			res = append(res, newAdminUser(&users[i]))
+		}
+		writeJSON(w, http.StatusOK, res)
+	case "POST":
+		var req adminUserRequest
+		if !decodeAdminRequest(w, r, &req) {
+			return
+		}
+		if len(req.Password) < db.MinPasswordLength {
+			writeAdminError(w, http.StatusBadRequest, "password needs to be at least %v characters long", db.MinPasswordLength)
+			return
+		}
+		u := &db.User{
+			Roles:       req.Roles,
+			Disabled:    req.Disabled,
+			LockedUntil: req.LockedUntil,
+			LockReason:  req.LockReason,
+		}
+		if u.Roles == nil {
+			u.Roles = []string{}
+		}
+		if !srv.applyAdminProfile(r.Context(), w, u, req.adminProfileRequest) {
+			return
+		}
+		hash, err := db.HashPassword(req.Password)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while hashing password", "error", err)
+			writeAdminError(w, http.StatusInternalServerError, "unable to hash password")
+			return
+		}
+		u.Password = hash
+		if err = srv.Database().CreateUser(r.Context(), u); err != nil {
+			writeAdminUpdateError(r.Context(), w, err, "create user")
+			return
+		}
+		slog.InfoContext(r.Context(), "admin api: created user", "subject", u.Subject())
+		writeJSON(w, http.StatusCreated, newAdminUser(u))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// applyAdminProfile validates the given request and copies its
+// profile fields to the given user. If the request is invalid,
+// an error response is written and false is returned.
+func (srv Server) applyAdminProfile(ctx context.Context, w http.ResponseWriter, u *db.User, req adminProfileRequest) bool {
+	if req.Username == "" && req.Mail == "" {
+		writeAdminError(w, http.StatusBadRequest, "username or mail needs to be set")
+		return false
+	}
+	err := db.CheckUnique(ctx, srv.Database(), u.ID, req.Username, req.Mail)
+	if errors.Is(err, db.ErrConflict) {
+		writeAdminError(w, http.StatusConflict, "%v", err)
+		return false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error while checking username and mail", "error", err)
+		writeAdminError(w, http.StatusInternalServerError, "unable to check username and mail")
+		return false
+	}
+	u.Username = req.Username
+	u.Name = req.Name
+	u.Mail = req.Mail
+	u.MailVerified = req.MailVerified
+	return true
+}
+
+// handleAdminUser serves the routes for a single user.
+func (srv Server) handleAdminUser(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/users/"), "/")
+	if len(parts) > 2 || parts[0] == "" {
+		writeAdminError(w, http.StatusNotFound, "not found")
+		return
+	}
+	u, err := srv.Database().FindUserByID(r.Context(), parts[0])
+	if errors.Is(err, db.ErrUserNotFound) {
+		writeAdminError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while searching user", "error", err)
+		writeAdminError(w, http.StatusInternalServerError, "unable to find user")
+		return
+	}
+	if len(parts) == 1 {
+		srv.handleAdminUserProfile(w, r, u)
+		return
+	}
+	switch parts[1] {
+	case "password":
+		srv.handleAdminUserPassword(w, r, u)
+	case "roles":
+		srv.handleAdminUserRoles(w, r, u)
+	case "disable", "enable":
+		srv.handleAdminUserDisable(w, r, u, parts[1] == "disable")
+	default:
+		writeAdminError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// handleAdminUserProfile gets, updates or deletes the given user.
+func (srv Server) handleAdminUserProfile(w http.ResponseWriter, r *http.Request, u *db.User) {
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, newAdminUser(u))
+	case "PUT":
+		var req adminProfileRequest
+		if !decodeAdminRequest(w, r, &req) {
+			return
+		}
+		if !srv.applyAdminProfile(r.Context(), w, u, req) {
+			return
+		}
+		if err := srv.Database().UpdateUser(r.Context(), u); err != nil {
+			writeAdminUpdateError(r.Context(), w, err, "update user")
+			return
+		}
+		slog.InfoContext(r.Context(), "admin api: updated user", "subject", u.Subject())
+		writeJSON(w, http.StatusOK, newAdminUser(u))
+	case "DELETE":
+		if err := srv.Database().DeleteUser(r.Context(), u.Subject()); err != nil {
+			writeAdminUpdateError(r.Context(), w, err, "delete user")
+			return
+		}
+		slog.InfoContext(r.Context(), "admin api: deleted user", "subject", u.Subject())
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleAdminUserPassword sets the password of the given user.
+func (srv Server) handleAdminUserPassword(w http.ResponseWriter, r *http.Request, u *db.User) {
+	if r.Method != "PUT" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		Password string `json:"password"`
+	}
+	if !decodeAdminRequest(w, r, &req) {
+		return
+	}
+	if len(req.Password) < db.MinPasswordLength {
+		writeAdminError(w, http.StatusBadRequest, "password needs to be at least %v characters long", db.MinPasswordLength)
+		return
+	}
+	hash, err := db.HashPassword(req.Password)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while hashing password", "error", err)
+		writeAdminError(w, http.StatusInternalServerError, "unable to hash password")
+		return
+	}
+	if err = srv.Database().UpdatePassword(r.Context(), u.Subject(), hash); err != nil {
+		writeAdminUpdateError(r.Context(), w, err, "update password")
+		return
+	}
+	slog.InfoContext(r.Context(), "admin api: set password of user", "subject", u.Subject())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminUserRoles sets the roles of the given user.
+func (srv Server) handleAdminUserRoles(w http.ResponseWriter, r *http.Request, u *db.User) {
+	if r.Method != "PUT" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		Roles []string `json:"roles"`
+	}
+	if !decodeAdminRequest(w, r, &req) {
+		return
+	}
+	if req.Roles == nil {
+		req.Roles = []string{}
+	}
+	if err := srv.Database().UpdateRoles(r.Context(), u.Subject(), req.Roles); err != nil {
+		writeAdminUpdateError(r.Context(), w, err, "update roles")
+		return
+	}
+	slog.InfoContext(r.Context(), "admin api: set roles of user", "subject", u.Subject(), "roles", req.Roles)
+	u.Roles = req.Roles
+	writeJSON(w, http.StatusOK, newAdminUser(u))
+}
+
+// handleAdminUserDisable disables or enables the given user.
+// When disabling, an optional reason can be given.
+func (srv Server) handleAdminUserDisable(w http.ResponseWriter, r *http.Request, u *db.User, disable bool) {
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if disable && r.ContentLength != 0 && !decodeAdminRequest(w, r, &req) {
+		return
+	}
+	if err := srv.Database().UpdateDisabled(r.Context(), u.Subject(), disable, req.Reason); err != nil {
+		writeAdminUpdateError(r.Context(), w, err, "update user")
+		return
+	}
+	slog.InfoContext(r.Context(), "admin api: set disabled of user", "subject", u.Subject(), "disabled", disable)
+	// like the database, keep the previous reason if none was given
+	u.Disabled = disable
+	if !disable {
+		u.LockReason = ""
+	} else if req.Reason != "" {
+		u.LockReason = req.Reason
+	}
+	writeJSON(w, http.StatusOK, newAdminUser(u))
+}
+
+// handleAdminThrottles lists the throttle keys which are blocked at the moment.
+func (srv Server) handleAdminThrottles(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	th := srv.throttles()
+	if th == nil {
+		writeAdminError(w, http.StatusNotFound, "brute-force protection is disabled")
+		return
+	}
+	blocked, err := th.Blocked(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while listing blocked logins", "error", err)
+		writeAdminError(w, http.StatusInternalServerError, "unable to list blocked logins")
+		return
+	}
+	type throttleResponse struct {
+		Key          string    `json:"key"`
+		Failures     int       `json:"failures"`
+		LastFailure  time.Time `json:"last_failure"`
+		BlockedUntil time.Time `json:"blocked_until"`
+	}
+	res := make([]throttleResponse, 0, len(blocked))
+	for _, lt := range blocked {
+		res = append(res, throttleResponse{
+			Key:          lt.Key,
+			Failures:     lt.Failures,
+			LastFailure:  lt.LastFailure,
+			BlockedUntil: lt.BlockedUntil,
+		})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// handleAdminThrottle clears the failed logins of a throttle key,
+// for example "user:<id>", "ip:<address>" or "reset-ip:<address>".
+func (srv Server) handleAdminThrottle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "DELETE" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	th := srv.throttles()
+	if th == nil {
+		writeAdminError(w, http.StatusNotFound, "brute-force protection is disabled")
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/throttles/")
+	if !throttle.ValidKey(key) {
+		writeAdminError(w, http.StatusBadRequest, "invalid throttle key '%s'", key)
+		return
+	}
+	if err := th.Reset(r.Context(), key); err != nil {
+		slog.ErrorContext(r.Context(), "error while clearing throttle", "error", err)
+		writeAdminError(w, http.StatusInternalServerError, "unable to clear throttle")
+		return
+	}
+	slog.InfoContext(r.Context(), "admin api: cleared throttle", "key", key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// queryInt returns the integer value of the given query parameter.
+// If the parameter is not set, the default value is returned.
+func queryInt(r *http.Request, name string, defaultValue int) (int, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(v)
+}
@@ -0,0 +1,223 @@
+package godra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+const testAdminToken = "0123456789abcdef0123456789abcdef"
+
+// failingLookupDatabase fails to search users by username or mail.
+type failingLookupDatabase struct {
+	db.Database
+}
+
+func (failingLookupDatabase) FindUserByUsernameOrMail(ctx context.Context, s string) (*db.User, error) {
+	return nil, errors.New("connection refused")
+}
+
+// failingWritesDatabase returns the given error when changing users.
+type failingWritesDatabase struct {
+	db.Database
+	err error
+}
+
+func (d failingWritesDatabase) UpdateUser(ctx context.Context, u *db.User) error { return d.err }
+func (d failingWritesDatabase) UpdatePassword(ctx context.Context, id string, hash string) error {
+	return d.err
+}
+func (d failingWritesDatabase) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	return d.err
+}
+func (d failingWritesDatabase) UpdateDisabled(ctx context.Context, id string, disabled bool, reason string) error {
+	return d.err
+}
+func (d failingWritesDatabase) DeleteUser(ctx context.Context, id string) error { return d.err }
+
+// newAdminTestServer creates a server with the admin api
+// and two users, of which the first one is disabled.
+func newAdminTestServer(t *testing.T, wrap func(db.Database) db.Database) (*Server, []*db.User) {
+	t.Helper()
+	d, _ := db.NewMemoryDatabase()
+	users := []*db.User{
+		{Username: "alice", Mail: "alice@example.com", Roles: []string{"admin"}, Disabled: true, LockReason: "left"},
+		{Username: "bob", Mail: "bob@example.com", Roles: []string{}},
+	}
+	for _, u := range users {
+		if err := d.CreateUser(context.Background(), u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var database db.Database = d
+	if wrap != nil {
+		database = wrap(d)
+	}
+	srv, err := NewServer(SetDatabase(database), SetAdminAPI(5001, testAdminToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, users
+}
+
+// adminRequest sends a request to the admin api.
+func adminRequest(srv *Server, method string, path string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer "+testAdminToken)
+	w := httptest.NewRecorder()
+	srv.GetAdminHandler().ServeHTTP(w, r)
+	return w
+}
+
+func TestAdminUpdateProfile(t *testing.T) {
+	srv, users := newAdminTestServer(t, nil)
+	alice := users[0]
+	w := adminRequest(srv, http.MethodPut, "/users/"+alice.Subject(), `{"username": "alice", "name": "Alice", "mail": "alice@example.org"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v: %v", w.Code, w.Body.String())
+	}
+	u, err := srv.Database().FindUserByID(context.Background(), alice.Subject())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != "Alice" || u.Mail != "alice@example.org" {
+		t.Fatalf("expected the profile to be updated, got %+v", u)
+	}
+	if !u.Disabled || u.LockReason != "left" || len(u.Roles) != 1 {
+		t.Fatalf("expected disabled flag and roles to be kept, got %+v", u)
+	}
+}
+
+func TestAdminUpdateProfileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		wrap func(db.Database) db.Database
+		body string
+		want int
+	}{
+		{
+			name: "roles",
+			body: `{"username": "alice", "roles": []}`,
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "disabled",
+			body: `{"username": "alice", "disabled": false}`,
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "mail in use",
+			body: `{"username": "alice", "mail": "bob@example.com"}`,
+			want: http.StatusConflict,
+		},
+		{
+			name: "database error",
+			wrap: func(d db.Database) db.Database { return failingLookupDatabase{d} },
+			body: `{"username": "alice"}`,
+			want: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, users := newAdminTestServer(t, tt.wrap)
+			w := adminRequest(srv, http.MethodPut, "/users/"+users[0].Subject(), tt.body)
+			if w.Code != tt.want {
+				t.Fatalf("expected status %v, got %v: %v", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAdminWriteErrors(t *testing.T) {
+	requests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: http.MethodPut, body: `{"username": "alice"}`},
+		{method: http.MethodDelete},
+		{method: http.MethodPut, path: "/password", body: `{"password": "secret123"}`},
+		{method: http.MethodPut, path: "/roles", body: `{"roles": []}`},
+		{method: http.MethodPost, path: "/disable"},
+		{method: http.MethodPost, path: "/enable"},
+	}
+	errs := []struct {
+		err  error
+		want int
+	}{
+		{err: db.ErrReadOnly, want: http.StatusMethodNotAllowed},
+		{err: fmt.Errorf("%w: deleted meanwhile", db.ErrUserNotFound), want: http.StatusNotFound},
+		{err: errors.New("connection refused"), want: http.StatusInternalServerError},
+	}
+	for _, e := range errs {
+		for _, req := range requests {
+			t.Run(fmt.Sprintf("%v %v %v", e.err, req.method, req.path), func(t *testing.T) {
+				srv, users := newAdminTestServer(t, func(d db.Database) db.Database { return failingWritesDatabase{d, e.err} })
+				w := adminRequest(srv, req.method, "/users/"+users[0].Subject()+req.path, req.body)
+				if w.Code != e.want {
+					t.Fatalf("expected status %v, got %v: %v", e.want, w.Code, w.Body.String())
+				}
+			})
+		}
+	}
+}
+
+func TestAdminDisableKeepsReason(t *testing.T) {
+	srv, users := newAdminTestServer(t, nil)
+	alice := users[0]
+	w := adminRequest(srv, http.MethodPost, "/users/"+alice.Subject()+"/disable", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v: %v", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"lock_reason":"left"`) {
+		t.Fatalf("expected the previous reason in the response, got %v", w.Body.String())
+	}
+	u, err := srv.Database().FindUserByID(context.Background(), alice.Subject())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !u.Disabled || u.LockReason != "left" {
+		t.Fatalf("expected the previous reason to be kept, got %+v", u)
+	}
+}
+
+func TestAdminClearThrottle(t *testing.T) {
+	tests := []struct {
+		key  string
+		want int
+	}{
+		{key: "user:0123", want: http.StatusNoContent},
+		{key: "ip:192.0.2.1", want: http.StatusNoContent},
+		{key: "totp:challenge", want: http.StatusNoContent},
+		{key: "reset-ip:192.0.2.1", want: http.StatusNoContent},
+		{key: "reset-user:alice", want: http.StatusNoContent},
+		{key: "reset-user:", want: http.StatusBadRequest},
+		{key: "unknown:alice", want: http.StatusBadRequest},
+	}
+	d, _ := db.NewMemoryDatabase()
+	th, err := throttle.New(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, err := NewServer(SetDatabase(d), SetThrottler(th), SetAdminAPI(5001, testAdminToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if _, err := d.IncrementLoginFailures(context.Background(), tt.key); err != nil {
+				t.Fatal(err)
+			}
+			if w := adminRequest(srv, http.MethodDelete, "/throttles/"+tt.key, ""); w.Code != tt.want {
+				t.Fatalf("expected status %v, got %v: %v", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package godra
+
+import (
+	"fmt"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/hydraclient"
+)
+
+// ClaimMapping changes the claims which are
+// added to the tokens issued through godra.
+type ClaimMapping struct {
+	// Rename maps claim names to new names.
+	// Mapping a claim to an empty name removes it.
+	Rename map[string]string `json:"rename"`
+	// Static claims are added with the given values.
+	Static map[string]interface{} `json:"static"`
+}
+
+// ClaimsConfig contains the claim mapping for all clients
+// and the client specific ones, which are applied afterwards.
+type ClaimsConfig struct {
+	Default ClaimMapping            `json:"default"`
+	Clients map[string]ClaimMapping `json:"clients"`
+}
+
+// claims which are set by hydra and cannot be changed.
+var reservedClaims = map[string]bool{
+	"iss": true, "sub": true, "aud": true, "exp": true, "iat": true, "nbf": true,
+	"jti": true, "auth_time": true, "nonce": true, "acr": true, "amr": true,
+	"at_hash": true, "c_hash": true, "sid": true, "rat": true,
+}
+
+// validate returns an error if the mapping changes reserved claims.
+func (m ClaimMapping) validate() error {
+	for from, to := range m.Rename {
+		if from == "" || reservedClaims[from] || reservedClaims[to] {
+			return fmt.Errorf("invalid rename from '%s' to '%s'", from, to)
+		}
+	}
+	for k := range m.Static {
+		if k == "" || reservedClaims[k] {
+			return fmt.Errorf("invalid static claim '%s'", k)
+		}
+	}
+	return nil
+}
+
+// apply returns the given claims renamed and with the static ones added.
+func (m ClaimMapping) apply(claims map[string]interface{}) map[string]interface{} {
+	mapped := make(map[string]interface{}, len(claims)+len(m.Static))
+	for k, v := range claims {
+		if to, ok := m.Rename[k]; ok {
+			if to != "" {
+				mapped[to] = v
+			}
+			continue
+		}
+		mapped[k] = v
+	}
+	for k, v := range m.Static {
+		mapped[k] = v
+	}
+	return mapped
+}
+
+// userClaims returns the claims of the given user which are
+// covered by the granted scopes, mapped for the given client.
+func (srv Server) userClaims(u *db.User, clientID string, scopes []string) map[string]interface{} {
+	claims := make(map[string]interface{})
+	for _, s := range scopes {
+		switch s {
+		case "email":
+			if u.Mail != "" {
+				claims["email"] = u.Mail
+				claims["email_verified"] = u.MailVerified
+			}
+		case "profile":
+			if u.Username != "" {
+				claims["preferred_username"] = u.Username
+			}
+			if u.Name != "" {
+				claims["name"] = u.Name
+			}
+		case "roles":
+			roles := u.Roles
+			if roles == nil {
+				roles = []string{}
+			}
+			claims["roles"] = roles
+		}
+	}
+	claims = srv.claims.Default.apply(claims)
+	if m, ok := srv.claims.Clients[clientID]; ok {
+		claims = m.apply(claims)
+	}
+	return claims
+}
+
+// consentSession returns the session containing the claims
+// of the given user for the id token and the access token.
+func (srv Server) consentSession(u *db.User, clientID string, scopes []string) hydraclient.ConsentSession {
+	claims := srv.userClaims(u, clientID, scopes)
+	if len(claims) == 0 {
+		return hydraclient.ConsentSession{}
+	}
+	// hydra adds the access token claims to the "ext" field
+	return hydraclient.ConsentSession{IDToken: claims, AccessToken: claims}
+}
@@ -0,0 +1,48 @@
+package godra
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// isTrustedProxy returns true if the given ip address
+// belongs to one of the trusted proxies.
+func (srv Server) isTrustedProxy(ip net.IP) bool {
+	for _, n := range srv.trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP determines the ip address of the client which sent the request.
+// The X-Forwarded-For header is only honoured if the request was
+// sent by a trusted proxy. The header is processed from right to left,
+// the first address which does not belong to a trusted proxy is returned.
+func (srv Server) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !srv.isTrustedProxy(ip) {
+		return host
+	}
+	var forwarded []string
+	for _, h := range r.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(h, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		f := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if f == nil {
+			break
+		}
+		ip = f
+		if !srv.isTrustedProxy(ip) {
+			break
+		}
+	}
+	return ip.String()
+}
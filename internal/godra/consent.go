@@ -1,32 +1,181 @@
 package godra
 
 import (
-	"log"
+	"html/template"
+	"log/slog"
 	"net/http"
+
+	"github.com/rbicker/godra/internal/hydraclient"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/metrics"
 )
 
-// GetConsentHandler handles the consent flow
-// as godra is intended for internal use,
-// all concent requests are accepted automatically
+// Scope describes a scope on the consent page.
+type Scope struct {
+	// Description is shown to the user instead of the scope's name.
+	Description string `json:"description"`
+	// Required scopes are always granted and cannot be unticked.
+	Required bool `json:"required"`
+}
+
+// default descriptions of well-known scopes.
+var defaultScopes = map[string]Scope{
+	"openid":         {Description: "Sign you in using your account", Required: true},
+	"offline":        {Description: "Stay signed in, even when you are not using the application"},
+	"offline_access": {Description: "Stay signed in, even when you are not using the application"},
+	"profile":        {Description: "Read your username and name"},
+	"email":          {Description: "Read your mail address"},
+	"roles":          {Description: "Read your roles"},
+}
+
+// inputs for the consent page.
+type consentInputs struct {
+	Challenge  string
+	Client     hydraclient.OAuth2Client
+	Scopes     []consentScope
+	Audiences  []string
+	Stylesheet template.HTML
+}
+
+// a requested scope on the consent page.
+type consentScope struct {
+	Name string
+	Scope
+}
+
+// GetConsentHandler handles the consent flow.
+// By default, all consent requests are accepted automatically,
+// as godra is intended for internal use.
+// If the consent prompt is enabled, the GET request shows a page
+// listing the client and the requested scopes, unless the client
+// is trusted or hydra remembered the user's decision. The POST
+// request grants the scopes ticked by the user or rejects the request.
 func (srv Server) GetConsentHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != "GET" {
+		switch r.Method {
+		case "GET":
+			c := r.URL.Query().Get("consent_challenge")
+			if c == "" {
+				slog.WarnContext(r.Context(), "received empty consent_challenge", "path", r.URL.Path)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			r = r.WithContext(logging.With(r.Context(), "consent_challenge", c))
+			body, err := srv.hydraclient.GetConsentRequestContext(r.Context(), c)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error while querying consent request", "error", err)
+				srv.renderHydraError(w, "consent", c, err)
+				return
+			}
+			r = r.WithContext(logging.With(r.Context(), "client_id", body.GetClient().ClientID, "subject", body.GetSubject()))
+			slog.InfoContext(r.Context(), "consent request received", "skip", body.GetSkip())
+			if !srv.consentPrompt || body.GetSkip() || srv.trustedClients[body.GetClient().ClientID] {
+				acceptConsent(w, r, srv, c, body, true, body.GetRequestedScope())
+				return
+			}
+			srv.renderConsentPage(w, c, body)
+		case "POST":
+			err := r.ParseForm()
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error parsing form in consent post request", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			c := r.FormValue("challenge")
+			r = r.WithContext(logging.With(r.Context(), "consent_challenge", c))
+			if r.FormValue("submit") == "deny" {
+				rejectConsent(w, r, srv, c)
+				return
+			}
+			body, err := srv.hydraclient.GetConsentRequestContext(r.Context(), c)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error while querying consent request", "error", err)
+				srv.renderHydraError(w, "consent", c, err)
+				return
+			}
+			r = r.WithContext(logging.With(r.Context(), "client_id", body.GetClient().ClientID, "subject", body.GetSubject()))
+			ticked := make(map[string]bool)
+			for _, s := range r.Form["scope"] {
+				ticked[s] = true
+			}
+			// only grant requested scopes
+			var grant []string
+			for _, s := range body.GetRequestedScope() {
+				if ticked[s] || srv.scope(s).Required {
+					grant = append(grant, s)
+				}
+			}
+			acceptConsent(w, r, srv, c, body, r.FormValue("remember") == "true", grant)
+		default:
 			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
-		c := r.URL.Query().Get("consent_challenge")
-		if c == "" {
-			log.Printf("received empty consent_challenge at: %v\n", r.URL.Path)
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
-		body, err := srv.hydraclient.GetConsentRequest(c)
-		if err != nil {
-			log.Printf("error while querying consent request: %v\n", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
 		}
-		bodyAccept, err := srv.hydraclient.AcceptConsentRequest(c, true, 7200, body.GetRequestedScope(), body.GetRequestedAccessTokenAudience())
-		http.Redirect(w, r, bodyAccept.GetRedirectTo(), http.StatusTemporaryRedirect)
 	}
 }
+
+// scope returns the description of the scope with the given name.
+func (srv Server) scope(name string) Scope {
+	if s, ok := srv.scopes[name]; ok {
+		return s
+	}
+	return Scope{Description: name}
+}
+
+// renderConsentPage renders the consent page for the given request.
+func (srv Server) renderConsentPage(w http.ResponseWriter, challenge string, body hydraclient.GetConsentRequestResponse) {
+	inputs := consentInputs{
+		Challenge:  challenge,
+		Client:     body.GetClient(),
+		Audiences:  body.GetRequestedAccessTokenAudience(),
+		Stylesheet: srv.stylesheet(),
+	}
+	if inputs.Client.ClientName == "" {
+		inputs.Client.ClientName = inputs.Client.ClientID
+	}
+	for _, s := range body.GetRequestedScope() {
+		inputs.Scopes = append(inputs.Scopes, consentScope{Name: s, Scope: srv.scope(s)})
+	}
+	srv.renderTemplate(w, "consent", inputs)
+}
+
+// acceptConsent grants the given scopes and the requested audiences
+// and redirects the user back to hydra. The claims of the user
+// covered by the granted scopes are added to the tokens.
+func acceptConsent(w http.ResponseWriter, r *http.Request, srv Server, challenge string, req hydraclient.GetConsentRequestResponse, remember bool, scopes []string) {
+	if scopes == nil {
+		scopes = []string{}
+	}
+	audiences := req.GetRequestedAccessTokenAudience()
+	if audiences == nil {
+		audiences = []string{}
+	}
+	u, err := srv.Database().FindUserByID(r.Context(), req.GetSubject())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while searching user for consent request", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	session := srv.consentSession(u, req.GetClient().ClientID, scopes)
+	body, err := srv.hydraclient.AcceptConsentRequestContext(r.Context(), challenge, remember, 7200, scopes, audiences, session)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while accepting consent request", "error", err)
+		srv.renderHydraError(w, "consent", challenge, err)
+		return
+	}
+	slog.InfoContext(r.Context(), "consent request accepted", "scopes", scopes, "remember", remember)
+	metrics.Consent(metrics.ConsentAccepted)
+	http.Redirect(w, r, body.GetRedirectTo(), http.StatusFound)
+}
+
+// rejectConsent rejects the consent request
+// and redirects the user back to hydra.
+func rejectConsent(w http.ResponseWriter, r *http.Request, srv Server, challenge string) {
+	body, err := srv.hydraclient.RejectConsentRequestContext(r.Context(), challenge, "access_denied", "The resource owner denied the request")
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while rejecting consent request", "error", err)
+		srv.renderHydraError(w, "consent", challenge, err)
+		return
+	}
+	slog.InfoContext(r.Context(), "consent request rejected")
+	metrics.Consent(metrics.ConsentRejected)
+	http.Redirect(w, r, body.GetRedirectTo(), http.StatusFound)
+}
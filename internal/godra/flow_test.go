@@ -0,0 +1,185 @@
+package godra
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/hydraclient"
+	"github.com/rbicker/godra/pkg/hydratest"
+)
+
+// newFlowTestServer creates a server using a fake hydra
+// and a user with the password "secret-password".
+func newFlowTestServer(t *testing.T) (*Server, *hydratest.Server, *db.User) {
+	t.Helper()
+	h := hydratest.NewServer()
+	t.Cleanup(h.Close)
+	d, _ := db.NewMemoryDatabase()
+	hash, err := db.HashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &db.User{Username: "alice", Mail: "alice@example.com", MailVerified: true, Password: hash, Roles: []string{}}
+	if err = d.CreateUser(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	srv, err := NewServer(SetDatabase(d), SetHydraClient(newHydraTestClient(t, h)), SetSecret("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, h, u
+}
+
+// newHydraTestClient returns a hydra client using the given fake server.
+// Retries and the circuit breaker are disabled, so injected errors
+// reach godra and tests do not influence each other. They can be
+// enabled again using the given options, which are applied after
+// the defaults.
+func newHydraTestClient(t *testing.T, h *hydratest.Server, opts ...func(*hydraclient.Client) error) hydraclient.Client {
+	t.Helper()
+	opts = append([]func(*hydraclient.Client) error{
+		hydraclient.SetRetries(0, 0),
+		hydraclient.SetCircuitBreaker(0, 0),
+	}, opts...)
+	c, err := hydraclient.New(h.URL, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// serve sends the request to the given handler and returns the response.
+func serve(h http.HandlerFunc, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h(w, r)
+	return w
+}
+
+// postForm creates a post request with the given form values.
+func postForm(path string, form url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestLoginConsentLogoutFlow(t *testing.T) {
+	srv, h, u := newFlowTestServer(t)
+	client := hydratest.OAuth2Client{ClientID: "app"}
+
+	// login
+	login := h.AddLoginRequest(hydratest.LoginRequest{Client: client, RequestedScope: []string{"openid"}})
+	w := serve(srv.GetLoginHandler(), httptest.NewRequest(http.MethodGet, "/login?login_challenge="+login, nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), login) {
+		t.Fatalf("expected the login form, got %v", w.Code)
+	}
+	form := url.Values{"challenge": {login}, "username": {"alice"}, "password": {"secret-password"}, "submit": {"login"}}
+	w = serve(srv.GetLoginHandler(), postForm("/login", form))
+	if got, want := w.Header().Get("Location"), h.RedirectURL("login", "accept", login); got != want {
+		t.Fatalf("expected redirect to %v, got %v (%v)", want, got, w.Code)
+	}
+	call, ok := h.LastCall("login", "accept")
+	if !ok {
+		t.Fatal("expected the login to be accepted")
+	}
+	var accepted hydratest.AcceptLoginBody
+	if err := call.Decode(&accepted); err != nil {
+		t.Fatal(err)
+	}
+	if accepted.Subject != u.Subject() {
+		t.Fatalf("expected subject %v, got %v", u.Subject(), accepted.Subject)
+	}
+
+	// consent
+	consent := h.AddConsentRequest(hydratest.ConsentRequest{
+		Subject:        u.Subject(),
+		Client:         client,
+		RequestedScope: []string{"openid", "email"},
+		LoginChallenge: login,
+	})
+	w = serve(srv.GetConsentHandler(), httptest.NewRequest(http.MethodGet, "/consent?consent_challenge="+consent, nil))
+	if got, want := w.Header().Get("Location"), h.RedirectURL("consent", "accept", consent); got != want {
+		t.Fatalf("expected redirect to %v, got %v (%v)", want, got, w.Code)
+	}
+	call, ok = h.LastCall("consent", "accept")
+	if !ok {
+		t.Fatal("expected the consent to be accepted")
+	}
+	var granted hydratest.AcceptConsentBody
+	if err := call.Decode(&granted); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(granted.GrantScope, " ") != "openid email" {
+		t.Fatalf("expected the scopes openid and email to be granted, got %v", granted.GrantScope)
+	}
+
+	// logout
+	logout := h.AddLogoutRequest(hydratest.LogoutRequest{Subject: u.Subject()})
+	w = serve(srv.GetLogoutHandler(), httptest.NewRequest(http.MethodGet, "/logout?logout_challenge="+logout, nil))
+	if got, want := w.Header().Get("Location"), h.RedirectURL("logout", "accept", logout); got != want {
+		t.Fatalf("expected redirect to %v, got %v (%v)", want, got, w.Code)
+	}
+	if _, ok = h.LastCall("logout", "accept"); !ok {
+		t.Fatal("expected the logout to be accepted")
+	}
+}
+
+func TestLoginFlowHydraErrors(t *testing.T) {
+	srv, h, _ := newFlowTestServer(t)
+	login := h.AddLoginRequest(hydratest.LoginRequest{})
+	h.InjectError("login", "get", hydratest.Error{StatusCode: http.StatusNotFound, Error: "not_found"})
+	w := serve(srv.GetLoginHandler(), httptest.NewRequest(http.MethodGet, "/login?login_challenge="+login, nil))
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "invalid or has expired") {
+		t.Fatalf("expected an invalid request, got %v", w.Code)
+	}
+	// the error is only returned once
+	w = serve(srv.GetLoginHandler(), httptest.NewRequest(http.MethodGet, "/login?login_challenge="+login, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected the login form, got %v", w.Code)
+	}
+	// a failing accept request is not redirected
+	h.InjectError("login", "accept", hydratest.Error{StatusCode: http.StatusConflict, Error: "request_handled"})
+	form := url.Values{"challenge": {login}, "username": {"alice"}, "password": {"secret-password"}, "submit": {"login"}}
+	w = serve(srv.GetLoginHandler(), postForm("/login", form))
+	if w.Code != http.StatusBadRequest || w.Header().Get("Location") != "" {
+		t.Fatalf("expected an invalid request, got %v", w.Code)
+	}
+}
+
+func TestLoginFlowHydraUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []func(*hydraclient.Client) error
+		want int
+	}{
+		{
+			name: "without retries",
+			want: http.StatusServiceUnavailable,
+		},
+		{
+			name: "with retries",
+			opts: []func(*hydraclient.Client) error{hydraclient.SetRetries(1, 0)},
+			want: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := hydratest.NewServer()
+			defer h.Close()
+			srv, err := NewServer(SetHydraClient(newHydraTestClient(t, h, tt.opts...)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			login := h.AddLoginRequest(hydratest.LoginRequest{})
+			h.InjectError("login", "get", hydratest.Error{StatusCode: http.StatusServiceUnavailable, Error: "unavailable"})
+			w := serve(srv.GetLoginHandler(), httptest.NewRequest(http.MethodGet, "/login?login_challenge="+login, nil))
+			if w.Code != tt.want {
+				t.Fatalf("expected status %v, got %v", tt.want, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package godra
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// healthCheckTimeout limits how long a single dependency check may take.
+const healthCheckTimeout = 2 * time.Second
+
+// dependencyStatus is the result of checking a single dependency.
+type dependencyStatus struct {
+	Status    string  `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+}
+
+// healthResponse is the body of the health and readiness checks.
+type healthResponse struct {
+	Status string                      `json:"status"`
+	Checks map[string]dependencyStatus `json:"checks,omitempty"`
+}
+
+// GetHealthHandler returns the handler for the liveness check,
+// which succeeds as long as the process is able to serve requests.
+func (srv Server) GetHealthHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		writeJSON(w, http.StatusOK, healthResponse{Status: "ok"})
+	}
+}
+
+// GetReadinessHandler returns the handler for the readiness check,
+// which verifies that the database and hydra are reachable.
+// Errors are logged and not returned, as the check is public.
+// It fails as soon as the server is shutting down, so no new
+// requests are sent while the in-flight ones are drained.
+func (srv Server) GetReadinessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		if srv.draining() {
+			writeJSON(w, http.StatusServiceUnavailable, healthResponse{Status: "shutting down"})
+			return
+		}
+		checks := map[string]func(context.Context) error{
+			"database": srv.db.Ping,
+			"hydra":    srv.hydraclient.Ready,
+		}
+		res := healthResponse{Status: "ok", Checks: make(map[string]dependencyStatus)}
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for name, check := range checks {
+			wg.Add(1)
+			go func(name string, check func(context.Context) error) {
+				defer wg.Done()
+				status, err := runCheck(r.Context(), check)
+				if err != nil {
+					slog.WarnContext(r.Context(), "readiness check failed", "dependency", name, "error", err)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				res.Checks[name] = status
+				if err != nil {
+					res.Status = "unavailable"
+				}
+			}(name, check)
+		}
+		wg.Wait()
+		if res.Status != "ok" {
+			writeJSON(w, http.StatusServiceUnavailable, res)
+			return
+		}
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+// runCheck runs the given dependency check and measures its latency.
+func runCheck(ctx context.Context, check func(context.Context) error) (dependencyStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	start := time.Now()
+	err := check(ctx)
+	status := dependencyStatus{
+		Status:    "ok",
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		status.Status = "unavailable"
+	}
+	return status, err
+}
@@ -1,71 +1,123 @@
 package godra
 
 import (
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"log"
+	"html/template"
+	"log/slog"
 	"net/http"
-	"os"
-	"text/template"
+	"net/url"
 
-	"github.com/rbicker/godra/internal/nogo"
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/hydraclient"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/metrics"
+	"github.com/rbicker/godra/internal/throttle"
 )
 
-// read content from template file located in the environment
-// variable with the given "envName". The string will be wrapped
-// in a template definition with the given "tmplName".
-// Errors will be logged.
-// On error or if environment variable is not defined, the string
-// given as "def" will be used.
-func readTemplateFromFile(tmplName string, envName string, def string) string {
-	content := def
-	if p, ok := os.LookupEnv(envName); ok {
-		file, err := os.Open(p)
-		if err != nil {
-			log.Printf("error while trying to open custom template file '%s' under '%s': %s\n", tmplName, p, err)
-		} else {
-			defer file.Close()
-			b, err := ioutil.ReadAll(file)
-			if err != nil {
-				log.Printf("error while trying to read custom template file '%s' under '%s': %s\n", tmplName, p, err)
-			} else {
-				content = string(b)
-			}
-		}
-	}
-	return fmt.Sprintf(`{{ define "%s" }}%s{{ end }}`, tmplName, content)
+// inputs for the login page.
+type loginInputs struct {
+	Challenge string
+	Alert     string
+	// ClientName is the name of the application
+	// which initiated the login.
+	ClientName string
+	// LoginHint prefills the username field.
+	LoginHint string
+	// RedirectTo replaces the form with a link
+	// leading back to the application.
+	RedirectTo string
+	// Failed replaces the form with the alert and, if
+	// RetryURL is set, a link to try again.
+	Failed        bool
+	RetryURL      string
+	WebAuthn      bool
+	PasswordReset bool
+	Stylesheet    template.HTML
 }
 
 // renderLoginForm renders the login form.
-func renderLoginForm(w http.ResponseWriter, challenge string, alert string) {
-	n, err := nogo.Get("/assets/templates/login.html")
+// The login request is queried from hydra to show the
+// requesting application, errors are only logged.
+func (srv Server) renderLoginForm(w http.ResponseWriter, r *http.Request, challenge string, alert string) {
+	body, err := srv.hydraclient.GetLoginRequestContext(r.Context(), challenge)
 	if err != nil {
-		log.Printf("error while opening login html file: %v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		slog.ErrorContext(r.Context(), "error while querying login request from hydra", "error", err)
 	}
-	header := readTemplateFromFile("header", "CUSTOM_HEADER_PATH", "<h2>Login</h2>")
-	footer := readTemplateFromFile("footer", "CUSTOM_FOOTER_PATH", "")
-	t, err := template.New("login").Parse(header + footer + string(n.Content))
-	if err != nil {
-		log.Printf("error while parsing login html template: %v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	srv.renderLoginRequest(w, challenge, body, alert)
+}
+
+// renderLoginRequest renders the login form for the given login request,
+// showing the name of the requesting client and prefilling the login hint.
+func (srv Server) renderLoginRequest(w http.ResponseWriter, challenge string, body hydraclient.GetLoginRequestResponse, alert string) {
+	inputs := loginInputs{
+		Challenge: challenge,
+		Alert:     alert,
 	}
-	var stylesheet string
-	if ss, ok := os.LookupEnv("CUSTOM_STYLESHEET_PATH"); ok {
-		stylesheet = fmt.Sprintf(`<link rel="stylesheet" type="text/css" href="%s">`, ss)
+	if body != nil {
+		client := body.GetClient()
+		inputs.ClientName = client.ClientName
+		if inputs.ClientName == "" {
+			inputs.ClientName = client.ClientID
+		}
+		inputs.LoginHint = body.GetOIDCContext().LoginHint
 	}
-	inputs := struct {
-		Challenge  string
-		Alert      string
-		Stylesheet string
-	}{
-		Challenge:  challenge,
-		Alert:      alert,
-		Stylesheet: stylesheet,
+	srv.renderLoginPage(w, inputs)
+}
+
+// renderLoginPage renders the login page with the given inputs.
+func (srv Server) renderLoginPage(w http.ResponseWriter, inputs loginInputs) {
+	inputs.WebAuthn = srv.webauthn != nil
+	inputs.PasswordReset = srv.passwordResetEnabled()
+	inputs.Stylesheet = srv.stylesheet()
+	srv.renderTemplate(w, "login", inputs)
+}
+
+// hydraErrorMessage returns the http status and the message
+// shown to the user for the given error of a request to hydra.
+func hydraErrorMessage(err error) (int, string) {
+	var e *hydraclient.Error
+	switch {
+	case errors.Is(err, hydraclient.ErrUnavailable):
+		return http.StatusServiceUnavailable, "The login service is temporarily unavailable, please try again in a moment."
+	case errors.As(err, &e) && e.StatusCode < 500:
+		return http.StatusBadRequest, "Your request is invalid or has expired, please return to the application and try again."
+	default:
+		return http.StatusInternalServerError, "Something went wrong, please try again later."
 	}
-	t.Execute(w, inputs)
+}
+
+// renderHydraError renders the login page explaining that the
+// request to hydra failed, instead of an empty error response.
+// Unless the request is invalid, a link to restart the given
+// flow with the given challenge is shown.
+func (srv Server) renderHydraError(w http.ResponseWriter, flow string, challenge string, err error) {
+	status, msg := hydraErrorMessage(err)
+	inputs := loginInputs{Alert: msg, Failed: true}
+	if status != http.StatusBadRequest && challenge != "" {
+		params := url.Values{}
+		params.Add(flow+"_challenge", challenge)
+		inputs.RetryURL = fmt.Sprintf("/%s?%s", flow, params.Encode())
+	}
+	w.WriteHeader(status)
+	srv.renderLoginPage(w, inputs)
+}
+
+// checkAccount verifies that the given user is allowed to log in.
+// If not, the hydra error id and a message
+// which can be shown to the user are returned.
+func checkAccount(u *db.User) (string, string) {
+	reason := ""
+	if u.LockReason != "" {
+		reason = fmt.Sprintf(" Reason: %s", u.LockReason)
+	}
+	if u.Disabled {
+		return "user_disabled", fmt.Sprintf("Your account has been disabled.%s", reason)
+	}
+	if u.Locked() {
+		return "user_locked", fmt.Sprintf("Your account is locked until %s.%s", u.LockedUntil.Format("2006-01-02 15:04 MST"), reason)
+	}
+	return "", ""
 }
 
 // GetLoginHandler returns the handler for the /login route.
@@ -102,20 +154,29 @@ func handleGet(w http.ResponseWriter, r *http.Request, srv Server) {
 		return
 	}
 
-	body, err := srv.hydraclient.GetLoginRequest(c)
+	r = r.WithContext(logging.With(r.Context(), "login_challenge", c))
+	body, err := srv.hydraclient.GetLoginRequestContext(r.Context(), c)
 	if err != nil {
-		log.Printf("error while querying login request from hydra: %v\n", err)
-		w.WriteHeader(http.StatusBadRequest)
+		slog.ErrorContext(r.Context(), "error while querying login request from hydra", "error", err)
+		srv.renderHydraError(w, "login", c, err)
 		return
 	}
+	r = r.WithContext(logging.With(r.Context(), "client_id", body.GetClient().ClientID))
+	slog.InfoContext(r.Context(), "login request received", "skip", body.GetSkip())
 	// is skip is false, we need to show a login form
 	if !body.GetSkip() {
-		renderLoginForm(w, c, "")
+		srv.renderLoginRequest(w, c, body, "")
 		return
 	}
 	// when skip is set, only verify if subject is a valid userid
-	// for the case the user was deleted
-	_, err = srv.Database().FindUserByID(body.GetSubject())
+	// for the case the user was deleted or disabled
+	r = r.WithContext(logging.With(r.Context(), "subject", body.GetSubject()))
+	u, err := srv.Database().FindUserByID(r.Context(), body.GetSubject())
+	if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+		slog.ErrorContext(r.Context(), "error while searching user for login request", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
 	if err != nil {
 		reject(
 			w,
@@ -127,49 +188,119 @@ func handleGet(w http.ResponseWriter, r *http.Request, srv Server) {
 		)
 		return
 	}
-	// TODO: implement disabled users
-	accept(w, r, srv, c, body.GetSubject())
+	if errorID, msg := checkAccount(u); errorID != "" {
+		rejectWithMessage(w, r, srv, c, errorID, msg)
+		return
+	}
+	if errorID, msg := srv.checkClient(u, body.GetClient()); errorID != "" {
+		rejectWithMessage(w, r, srv, c, errorID, msg)
+		return
+	}
+	metrics.LoginAttempt(metrics.LoginSkipAccepted)
+	accept(w, r, srv, c, body.GetSubject(), "", nil)
 }
 
 // handle login request
 // The function receives a mail and password, sent by a form.
 // It verifies the login and does either an accept or a reject.
+// If the user has enrolled a totp device, the totp form
+// is shown instead of accepting the login right away.
 func handlePost(w http.ResponseWriter, r *http.Request, srv Server) {
 	err := r.ParseForm()
 	if err != nil {
-		log.Printf("error parsing form in login post request: %v\n", err)
+		slog.ErrorContext(r.Context(), "error parsing form in login post request", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 	submit, challenge, username, password := r.FormValue("submit"), r.FormValue("challenge"), r.FormValue("username"), r.FormValue("password")
+	r = r.WithContext(logging.With(r.Context(), "login_challenge", challenge))
 	if submit == "cancel" {
+		slog.InfoContext(r.Context(), "login cancelled")
+		metrics.LoginAttempt(metrics.LoginCancelled)
 		reject(w, r, srv, challenge, "cancelled", "login was cancelled by the user")
 		return
 	}
 	if username == "" || password == "" {
-		renderLoginForm(w, challenge, "Username or Password not set.")
+		srv.renderLoginForm(w, r, challenge, "Username or Password not set.")
+		return
+	}
+	ipKey := throttle.IPKey(srv.clientIP(r))
+	if msg, blocked := srv.throttled(r.Context(), ipKey); blocked {
+		srv.renderLoginForm(w, r, challenge, msg)
+		return
+	}
+	u, err := srv.Database().FindUserByUsernameOrMail(r.Context(), username)
+	if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+		srv.releaseAttempt(r.Context(), ipKey)
+		slog.ErrorContext(r.Context(), "error while searching user", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	u, err := srv.Database().FindUserByUsernameOrMail(username)
 	if err != nil {
-		renderLoginForm(w, challenge, fmt.Sprintf("User '%s' not found.", username))
+		slog.InfoContext(r.Context(), "login failed, unknown user")
+		metrics.LoginAttempt(metrics.LoginUnknownUser)
+		srv.renderLoginForm(w, r, challenge, fmt.Sprintf("User '%s' not found.", username))
+		return
+	}
+	r = r.WithContext(logging.With(r.Context(), "subject", u.Subject()))
+	userKey := throttle.UserKey(u.Subject())
+	if msg, blocked := srv.throttled(r.Context(), userKey); blocked {
+		srv.renderLoginForm(w, r, challenge, msg)
+		return
+	}
+	err = db.CheckPassword(r.Context(), srv.Database(), u, password)
+	if err != nil && !errors.Is(err, db.ErrInvalidCredentials) {
+		srv.releaseAttempt(r.Context(), ipKey, userKey)
+		slog.ErrorContext(r.Context(), "error while checking password", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	err = u.ValidatePassword(password)
 	if err != nil {
-		renderLoginForm(w, challenge, fmt.Sprintf("Invalid password for user '%s'.", username))
+		slog.InfoContext(r.Context(), "login failed, invalid password")
+		metrics.LoginAttempt(metrics.LoginBadPassword)
+		srv.renderLoginForm(w, r, challenge, fmt.Sprintf("Invalid password for user '%s'.", username))
 		return
 	}
-	accept(w, r, srv, challenge, u.ID.Hex())
-
+	errorID, msg, err := srv.checkLogin(r.Context(), challenge, u)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while checking login", "error", err)
+		srv.renderHydraError(w, "login", challenge, err)
+		return
+	}
+	if errorID != "" {
+		rejectWithMessage(w, r, srv, challenge, errorID, msg)
+		return
+	}
+	// users with an enrolled totp device need to provide
+	// a one-time password before the login gets accepted
+	if u.TOTPEnabled() {
+		token, err := srv.signToken("totp", totpTokenTTL, challenge, u.Subject())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while creating totp token", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		slog.InfoContext(r.Context(), "password verified, one-time password required")
+		// the one-time password is counted as a separate attempt
+		srv.releaseAttempt(r.Context(), ipKey, userKey)
+		srv.renderTOTPForm(w, challenge, token, "")
+		return
+	}
+	srv.releaseAttempt(r.Context(), ipKey)
+	srv.loginSucceeded(r.Context(), userKey)
+	metrics.LoginAttempt(metrics.LoginSuccess)
+	accept(w, r, srv, challenge, u.Subject(), "", []string{"pwd"})
 }
 
 // accept the logon request
-func accept(w http.ResponseWriter, r *http.Request, srv Server, challenge string, userID string) {
-	body, err := srv.hydraclient.AcceptLoginRequest(challenge, true, 7200, userID)
+// The acr and amr values describe how the user was authenticated.
+func accept(w http.ResponseWriter, r *http.Request, srv Server, challenge string, userID string, acr string, amr []string) {
+	r = r.WithContext(logging.With(r.Context(), "login_challenge", challenge, "subject", userID))
+	slog.InfoContext(r.Context(), "accepting login request", "amr", amr)
+	body, err := srv.hydraclient.AcceptLoginRequestContext(r.Context(), challenge, true, 7200, userID, acr, amr)
 	if err != nil {
-		log.Printf("error while accepting login request: %v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "error while accepting login request", "error", err)
+		srv.renderHydraError(w, "login", challenge, err)
 		return
 	}
 	http.Redirect(w, r, body.GetRedirectTo(), http.StatusTemporaryRedirect)
@@ -177,11 +308,31 @@ func accept(w http.ResponseWriter, r *http.Request, srv Server, challenge string
 
 // reject the logon request
 func reject(w http.ResponseWriter, r *http.Request, srv Server, challenge string, errorID string, errorDescription string) {
-	body, err := srv.hydraclient.RejectLoginRequest(challenge, errorID, errorDescription)
+	r = r.WithContext(logging.With(r.Context(), "login_challenge", challenge))
+	slog.InfoContext(r.Context(), "rejecting login request", "error_id", errorID)
+	body, err := srv.hydraclient.RejectLoginRequestContext(r.Context(), challenge, errorID, errorDescription)
 	if err != nil {
-		log.Printf("error while rejecting login request: %v\n", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "error while rejecting login request", "error", err)
+		srv.renderHydraError(w, "login", challenge, err)
 		return
 	}
 	http.Redirect(w, r, body.GetRedirectTo(), http.StatusTemporaryRedirect)
 }
+
+// rejectWithMessage rejects the logon request and shows the given
+// description on the login page, together with a link
+// leading back to the application.
+func rejectWithMessage(w http.ResponseWriter, r *http.Request, srv Server, challenge string, errorID string, errorDescription string) {
+	r = r.WithContext(logging.With(r.Context(), "login_challenge", challenge))
+	slog.InfoContext(r.Context(), "rejecting login request", "error_id", errorID)
+	body, err := srv.hydraclient.RejectLoginRequestContext(r.Context(), challenge, errorID, errorDescription)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while rejecting login request", "error", err)
+		srv.renderHydraError(w, "login", challenge, err)
+		return
+	}
+	srv.renderLoginPage(w, loginInputs{
+		Alert:      errorDescription,
+		RedirectTo: body.GetRedirectTo(),
+	})
+}
@@ -0,0 +1,91 @@
+package godra
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/throttle"
+	"github.com/rbicker/godra/pkg/hydratest"
+)
+
+// unreachablePasswordDatabase fails to validate passwords,
+// like an ldap database whose server is unreachable.
+type unreachablePasswordDatabase struct {
+	db.Database
+}
+
+func (unreachablePasswordDatabase) ValidatePassword(ctx context.Context, u *db.User, plainPassword string) error {
+	return errors.New("unable to connect to ldap server")
+}
+
+func TestLoginPasswordErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		wrap         func(db.Database) db.Database
+		password     string
+		wantCode     int
+		wantFailures int
+	}{
+		{
+			name:         "wrong password",
+			password:     "wrong-password",
+			wantCode:     http.StatusOK,
+			wantFailures: 1,
+		},
+		{
+			name:     "correct password",
+			password: "secret-password",
+			wantCode: http.StatusTemporaryRedirect,
+		},
+		{
+			name:     "password validation failed",
+			wrap:     func(d db.Database) db.Database { return unreachablePasswordDatabase{d} },
+			password: "secret-password",
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := hydratest.NewServer()
+			defer h.Close()
+			d, _ := db.NewMemoryDatabase()
+			hash, _ := db.HashPassword("secret-password")
+			u := &db.User{Username: "alice", Password: hash, Roles: []string{}}
+			if err := d.CreateUser(context.Background(), u); err != nil {
+				t.Fatal(err)
+			}
+			var database db.Database = d
+			if tt.wrap != nil {
+				database = tt.wrap(d)
+			}
+			th, err := throttle.New(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv, err := NewServer(SetDatabase(database), SetHydraClient(newHydraTestClient(t, h)), SetThrottler(th))
+			if err != nil {
+				t.Fatal(err)
+			}
+			login := h.AddLoginRequest(hydratest.LoginRequest{})
+			form := url.Values{"challenge": {login}, "username": {"alice"}, "password": {tt.password}, "submit": {"login"}}
+			w := serve(srv.GetLoginHandler(), postForm("/login", form))
+			if w.Code != tt.wantCode {
+				t.Fatalf("expected status %v, got %v", tt.wantCode, w.Code)
+			}
+			// the attempt is counted for the user and the client's ip address
+			for _, key := range []string{throttle.UserKey(u.Subject()), throttle.IPKey("192.0.2.1")} {
+				lt, err := d.FindLoginThrottle(context.Background(), key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if lt.Failures != tt.wantFailures {
+					t.Fatalf("%v: expected %v failed logins, got %v", key, tt.wantFailures, lt.Failures)
+				}
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package godra
 
 import (
-	"log"
+	"log/slog"
 	"net/http"
+
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/metrics"
 )
 
 // GetLogoutHandler handles the logout flow.
@@ -15,17 +18,29 @@ func (srv Server) GetLogoutHandler() http.HandlerFunc {
 		}
 		c := r.URL.Query().Get("logout_challenge")
 		if c == "" {
-			log.Printf("received empty logout_challenge at: %v\n", r.URL.Path)
+			slog.WarnContext(r.Context(), "received empty logout_challenge", "path", r.URL.Path)
 			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
-		_, err := srv.hydraclient.GetLogoutRequest(c)
+		r = r.WithContext(logging.With(r.Context(), "logout_challenge", c))
+		body, err := srv.hydraclient.GetLogoutRequestContext(r.Context(), c)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while querying logout request", "error", err)
+			srv.renderHydraError(w, "logout", c, err)
+			return
+		}
+		r = r.WithContext(logging.With(r.Context(), "subject", body.GetSubject()))
+		if client := body.GetClient(); client != nil {
+			r = r.WithContext(logging.With(r.Context(), "client_id", client.ClientID))
+		}
+		bodyAccept, err := srv.hydraclient.AcceptLogoutRequestContext(r.Context(), c)
 		if err != nil {
-			log.Printf("error while querying logout request: %v\n", err)
-			w.WriteHeader(http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "error while accepting logout request", "error", err)
+			srv.renderHydraError(w, "logout", c, err)
 			return
 		}
-		bodyAccept, err := srv.hydraclient.AcceptLogoutRequest(c)
+		slog.InfoContext(r.Context(), "logout request accepted")
+		metrics.Logout()
 		http.Redirect(w, r, bodyAccept.GetRedirectTo(), http.StatusTemporaryRedirect)
 	}
 }
@@ -0,0 +1,73 @@
+package godra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/hydraclient"
+)
+
+// Policy defines which users are allowed to log in to which clients.
+type Policy struct {
+	// Clients maps client ids to the roles required to log in.
+	// Users need to have at least one of the roles.
+	Clients map[string][]string `json:"clients"`
+	// Default contains the roles required to log in to clients
+	// which are not listed. If empty, all users are allowed.
+	Default []string `json:"default"`
+}
+
+// allowed returns true if the given user
+// is allowed to log in to the given client.
+func (p *Policy) allowed(u *db.User, clientID string) bool {
+	required, ok := p.Clients[clientID]
+	if !ok {
+		required = p.Default
+	}
+	if len(required) == 0 {
+		return true
+	}
+	for _, r := range required {
+		for _, role := range u.Roles {
+			if r == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkClient verifies that the given user is allowed to log in
+// to the given client. If not, the hydra error id and a message
+// which can be shown to the user are returned.
+func (srv Server) checkClient(u *db.User, client hydraclient.OAuth2Client) (string, string) {
+	if srv.policy == nil || srv.policy.allowed(u, client.ClientID) {
+		return "", ""
+	}
+	name := client.ClientName
+	if name == "" {
+		name = client.ClientID
+	}
+	return "access_denied", fmt.Sprintf("You are not allowed to access %s.", name)
+}
+
+// checkLogin verifies that the given user is allowed to log in
+// using the given login challenge. It checks the account and,
+// if a policy is configured, the roles required by the client.
+// If the login is not allowed, the hydra error id and a message
+// which can be shown to the user are returned.
+func (srv Server) checkLogin(ctx context.Context, challenge string, u *db.User) (string, string, error) {
+	if errorID, msg := checkAccount(u); errorID != "" {
+		return errorID, msg, nil
+	}
+	if srv.policy == nil {
+		return "", "", nil
+	}
+	body, err := srv.hydraclient.GetLoginRequestContext(ctx, challenge)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to query login request: %w", err)
+	}
+	errorID, msg := srv.checkClient(u, body.GetClient())
+	return errorID, msg, nil
+}
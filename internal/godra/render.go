@@ -0,0 +1,80 @@
+package godra
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/rbicker/godra/internal/nogo"
+)
+
+// Customization describes the custom static files, stylesheet
+// and html snippets used to brand the pages.
+type Customization struct {
+	// StaticPath is a directory served under /static/.
+	StaticPath string
+	// StylesheetPath is the url of a stylesheet included in all pages.
+	StylesheetPath string
+	// HeaderPath is a file containing the html shown above the pages.
+	HeaderPath string
+	// FooterPath is a file containing the html shown below the pages.
+	FooterPath string
+}
+
+// readTemplateFromFile reads the content of the file with the
+// given path. The string will be wrapped in a template
+// definition with the given "tmplName".
+// Errors will be logged.
+// On error or if no path is given, the string
+// given as "def" will be used.
+func readTemplateFromFile(tmplName string, path string, def string) string {
+	content := def
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			slog.Error("error while trying to open custom template file", "template", tmplName, "path", path, "error", err)
+		} else {
+			defer file.Close()
+			b, err := ioutil.ReadAll(file)
+			if err != nil {
+				slog.Error("error while trying to read custom template file", "template", tmplName, "path", path, "error", err)
+			} else {
+				content = string(b)
+			}
+		}
+	}
+	return fmt.Sprintf(`{{ define "%s" }}%s{{ end }}`, tmplName, content)
+}
+
+// stylesheet returns the html link to the custom stylesheet
+// or an empty string if no custom stylesheet is configured.
+func (srv Server) stylesheet() template.HTML {
+	if ss := srv.customization.StylesheetPath; ss != "" {
+		return template.HTML(fmt.Sprintf(`<link rel="stylesheet" type="text/css" href="%s">`, template.HTMLEscapeString(ss)))
+	}
+	return ""
+}
+
+// renderTemplate renders the html template with the given name
+// from the assets directory, using the given inputs.
+// The custom header and footer are available to all templates.
+func (srv Server) renderTemplate(w http.ResponseWriter, name string, inputs interface{}) {
+	n, err := nogo.Get(fmt.Sprintf("/assets/templates/%s.html", name))
+	if err != nil {
+		slog.Error("error while opening html file", "template", name, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	header := readTemplateFromFile("header", srv.customization.HeaderPath, "<h2>Login</h2>")
+	footer := readTemplateFromFile("footer", srv.customization.FooterPath, "")
+	t, err := template.New(name).Parse(header + footer + string(n.Content))
+	if err != nil {
+		slog.Error("error while parsing html template", "template", name, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	t.Execute(w, inputs)
+}
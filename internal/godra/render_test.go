@@ -0,0 +1,33 @@
+package godra
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rbicker/godra/internal/hydraclient"
+)
+
+func TestRenderTemplateEscapesValues(t *testing.T) {
+	srv, err := NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	srv.renderTemplate(w, "consent", consentInputs{
+		Client: hydraclient.OAuth2Client{
+			ClientName: `<script>alert(1)</script>`,
+			PolicyURI:  `javascript:alert(1)`,
+		},
+	})
+	body := w.Body.String()
+	if strings.Contains(body, "<script>alert(1)</script>") {
+		t.Fatal("expected the client name to be escaped")
+	}
+	if !strings.Contains(body, "&lt;script&gt;alert(1)&lt;/script&gt;") {
+		t.Fatalf("expected the escaped client name, got %v", body)
+	}
+	if strings.Contains(body, `href="javascript:`) {
+		t.Fatal("expected the javascript url to be rejected")
+	}
+}
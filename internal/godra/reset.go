@@ -0,0 +1,256 @@
+package godra
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+// inputs for the password reset page.
+type resetInputs struct {
+	Challenge  string
+	Token      string
+	Alert      string
+	Info       string
+	Stylesheet template.HTML
+}
+
+// renderResetForm renders the password reset page.
+// If a token is given, the page asks for the new password.
+// Otherwise it asks for the username or mail address.
+func (srv Server) renderResetForm(w http.ResponseWriter, inputs resetInputs) {
+	inputs.Stylesheet = srv.stylesheet()
+	srv.renderTemplate(w, "password-reset", inputs)
+}
+
+// resetRequestTimeout limits the time for creating a
+// password reset request and sending the mail.
+const resetRequestTimeout = 30 * time.Second
+
+// resetID returns the id under which the password
+// reset request for the given random value is stored.
+func resetID(random string) string {
+	sum := sha256.Sum256([]byte(random))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetPasswordResetHandler returns the handler for the /password-reset route.
+// Without a token, the GET request shows a form asking for the username
+// or mail address and the POST request sends a mail containing a link
+// with a single-use token to the user.
+// With a token, the GET request shows a form asking for the new password
+// and the POST request saves it. Afterwards, the user is returned to
+// the login flow if the login challenge is still valid.
+func (srv Server) GetPasswordResetHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !srv.passwordResetEnabled() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "GET":
+			token := r.URL.Query().Get("token")
+			if token == "" {
+				srv.renderResetForm(w, resetInputs{Challenge: r.URL.Query().Get("login_challenge")})
+				return
+			}
+			if _, err := srv.findPasswordReset(r.Context(), token); err != nil {
+				srv.renderResetForm(w, resetInputs{Alert: "The link is invalid or has expired, please request a new one."})
+				return
+			}
+			srv.renderResetForm(w, resetInputs{Token: token})
+		case "POST":
+			err := r.ParseForm()
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error parsing form in password reset post request", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if r.FormValue("token") != "" {
+				handleResetPassword(w, r, srv)
+				return
+			}
+			handleResetRequest(w, r, srv)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// handleResetRequest creates a password reset request
+// and sends the link to the user's mail address.
+// To not disclose which accounts exist, the same message is shown
+// whether the user was found or not and the request is processed
+// in the background, so the response time does not differ either.
+// Every request counts towards the throttle of the client's ip address
+// and of the entered name, so mails cannot be sent at will,
+// even if the brute-force protection is disabled.
+func handleResetRequest(w http.ResponseWriter, r *http.Request, srv Server) {
+	challenge, username := r.FormValue("challenge"), r.FormValue("username")
+	if username == "" {
+		srv.renderResetForm(w, resetInputs{Challenge: challenge, Alert: "Username or Mail not set."})
+		return
+	}
+	keys := []string{throttle.ResetIPKey(srv.clientIP(r)), throttle.ResetUserKey(username)}
+	if msg, blocked := attempt(r.Context(), srv.resetThrottler, keys...); blocked {
+		srv.renderResetForm(w, resetInputs{Challenge: challenge, Alert: msg})
+		return
+	}
+	// keep the logging attributes, but not the cancellation of the request
+	ctx := context.WithoutCancel(r.Context())
+	srv.background(func() {
+		ctx, cancel := context.WithTimeout(ctx, resetRequestTimeout)
+		defer cancel()
+		srv.sendPasswordReset(ctx, username, challenge)
+	})
+	srv.renderResetForm(w, resetInputs{
+		Challenge: challenge,
+		Info:      "If an account with a mail address exists, a mail containing a link to reset the password has been sent.",
+	})
+}
+
+// sendPasswordReset creates a password reset request for the user
+// with the given username or mail address and mails the link to the user.
+// Errors are logged, as the response has already been sent.
+func (srv Server) sendPasswordReset(ctx context.Context, username string, challenge string) {
+	u, err := srv.Database().FindUserByUsernameOrMail(ctx, username)
+	if errors.Is(err, db.ErrUserNotFound) {
+		slog.InfoContext(ctx, "password reset requested for unknown user")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error while searching user for password reset", "error", err)
+		return
+	}
+	ctx = logging.With(ctx, "subject", u.Subject())
+	if errorID, _ := checkAccount(u); errorID != "" || u.Mail == "" {
+		slog.InfoContext(ctx, "password reset requested for user without mail address or disabled user")
+		return
+	}
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		slog.ErrorContext(ctx, "error while generating password reset token", "error", err)
+		return
+	}
+	random := base64.RawURLEncoding.EncodeToString(b)
+	token, err := srv.signToken("password-reset", srv.resetTTL, random)
+	if err != nil {
+		slog.ErrorContext(ctx, "error while signing password reset token", "error", err)
+		return
+	}
+	err = srv.Database().CreatePasswordReset(ctx, &db.PasswordReset{
+		ID:        resetID(random),
+		UserID:    u.Subject(),
+		Challenge: challenge,
+		ExpiresAt: time.Now().Add(srv.resetTTL),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "error while saving password reset", "error", err)
+		return
+	}
+	link := fmt.Sprintf("%s/password-reset?token=%s", strings.TrimSuffix(srv.publicURL, "/"), url.QueryEscape(token))
+	body := fmt.Sprintf(`Hello,
+
+a password reset was requested for your account.
+Please use the following link to set a new password:
+
+%s
+
+The link is valid for %s and can only be used once.
+If you did not request a password reset, you can ignore this mail.
+`, link, srv.resetTTL)
+	if err = srv.mailSender.Send(u.Mail, "Reset your password", body); err != nil {
+		slog.ErrorContext(ctx, "error while sending password reset mail", "error", err)
+		return
+	}
+	slog.InfoContext(ctx, "password reset mail sent")
+}
+
+// findPasswordReset verifies the given token and returns
+// the corresponding password reset request.
+func (srv Server) findPasswordReset(ctx context.Context, token string) (*db.PasswordReset, error) {
+	values, err := srv.verifyToken("password-reset", token)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("invalid password reset token")
+	}
+	reset, err := srv.Database().FindPasswordReset(ctx, resetID(values[0]))
+	if err != nil {
+		return nil, err
+	}
+	if reset.Expired() {
+		return nil, fmt.Errorf("password reset expired")
+	}
+	return reset, nil
+}
+
+// handleResetPassword saves the new password and returns
+// the user to the login flow if possible.
+func handleResetPassword(w http.ResponseWriter, r *http.Request, srv Server) {
+	token, password, confirm := r.FormValue("token"), r.FormValue("password"), r.FormValue("confirm")
+	reset, err := srv.findPasswordReset(r.Context(), token)
+	if err != nil {
+		srv.renderResetForm(w, resetInputs{Alert: "The link is invalid or has expired, please request a new one."})
+		return
+	}
+	if len(password) < db.MinPasswordLength {
+		srv.renderResetForm(w, resetInputs{Token: token, Alert: fmt.Sprintf("The password needs to be at least %v characters long.", db.MinPasswordLength)})
+		return
+	}
+	if password != confirm {
+		srv.renderResetForm(w, resetInputs{Token: token, Alert: "The passwords do not match."})
+		return
+	}
+	// deleting the request first ensures it is only used once
+	if err = srv.Database().DeletePasswordReset(r.Context(), reset.ID); err != nil {
+		srv.renderResetForm(w, resetInputs{Alert: "The link is invalid or has expired, please request a new one."})
+		return
+	}
+	hash, err := db.HashPassword(password)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while hashing password", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	err = srv.Database().UpdatePassword(r.Context(), reset.UserID, hash)
+	if errors.Is(err, db.ErrReadOnly) {
+		srv.renderResetForm(w, resetInputs{Alert: "Your password cannot be changed here, please contact your administrator."})
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while updating password", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	// links mailed earlier must not change the password again
+	if err = srv.Database().DeletePasswordResets(r.Context(), reset.UserID); err != nil {
+		slog.ErrorContext(r.Context(), "error while removing password resets", "error", err)
+	}
+	srv.loginSucceeded(r.Context(), throttle.UserKey(reset.UserID))
+	// return to the login flow if the challenge is still valid
+	if reset.Challenge != "" {
+		if _, err = srv.hydraclient.GetLoginRequestContext(r.Context(), reset.Challenge); err == nil {
+			params := url.Values{}
+			params.Add("login_challenge", reset.Challenge)
+			http.Redirect(w, r, "/login?"+params.Encode(), http.StatusSeeOther)
+			return
+		}
+	}
+	srv.renderResetForm(w, resetInputs{Info: "Your password has been changed."})
+}
@@ -0,0 +1,175 @@
+package godra
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+// recordingSender records the mails instead of sending them.
+type recordingSender struct {
+	mu  sync.Mutex
+	to  []string
+	err error
+}
+
+func (s *recordingSender) Send(to string, subject string, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.to = append(s.to, to)
+	return s.err
+}
+
+func (s *recordingSender) sent() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.to...)
+}
+
+// readOnlyDatabase behaves like a database which cannot change users.
+type readOnlyDatabase struct {
+	db.Database
+}
+
+func (readOnlyDatabase) ReadOnly() bool { return true }
+
+// newResetTestServer creates a server with a recording mail sender
+// and a user with a mail address.
+func newResetTestServer(t *testing.T, d db.Database, opts ...func(*Server) error) (*Server, *recordingSender) {
+	t.Helper()
+	u := &db.User{Username: "alice", Mail: "alice@example.com", Roles: []string{}}
+	if err := d.CreateUser(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	sender := &recordingSender{}
+	opts = append([]func(*Server) error{SetDatabase(d), SetMailSender(sender), SetSecret("0123456789abcdef0123456789abcdef")}, opts...)
+	srv, err := NewServer(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, sender
+}
+
+// postResetRequest requests a password reset for the given username.
+func postResetRequest(srv *Server, username string) *httptest.ResponseRecorder {
+	form := url.Values{"username": {username}}
+	r := httptest.NewRequest(http.MethodPost, "/password-reset", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	srv.GetPasswordResetHandler()(w, r)
+	return w
+}
+
+func TestPasswordResetDoesNotDiscloseUsers(t *testing.T) {
+	d, _ := db.NewMemoryDatabase()
+	srv, sender := newResetTestServer(t, d)
+	known := postResetRequest(srv, "alice")
+	unknown := postResetRequest(srv, "bob")
+	srv.state.background.Wait()
+	if known.Code != http.StatusOK || unknown.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v and %v", known.Code, unknown.Code)
+	}
+	if known.Body.String() != unknown.Body.String() {
+		t.Fatal("expected the same response for known and unknown users")
+	}
+	if got := sender.sent(); len(got) != 1 || got[0] != "alice@example.com" {
+		t.Fatalf("expected a single mail to alice@example.com, got %v", got)
+	}
+}
+
+func TestPasswordResetRequestsAreThrottled(t *testing.T) {
+	d, _ := db.NewMemoryDatabase()
+	th, err := throttle.New(d, throttle.SetFreeAttempts(1), throttle.SetDelay(time.Minute, time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, sender := newResetTestServer(t, d, SetThrottler(th))
+	for i := 0; i < 2; i++ {
+		if w := postResetRequest(srv, "alice"); strings.Contains(w.Body.String(), "Too many") {
+			t.Fatalf("request %v: unexpected throttling", i+1)
+		}
+	}
+	if w := postResetRequest(srv, "ALICE"); !strings.Contains(w.Body.String(), "Too many") {
+		t.Fatal("expected the request to be throttled")
+	}
+	srv.state.background.Wait()
+	if got := sender.sent(); len(got) != 2 {
+		t.Fatalf("expected 2 mails, got %v", len(got))
+	}
+}
+
+func TestPasswordResetRequiresWritableDatabase(t *testing.T) {
+	d, _ := db.NewMemoryDatabase()
+	srv, sender := newResetTestServer(t, readOnlyDatabase{d})
+	if srv.passwordResetEnabled() {
+		t.Fatal("expected the password reset to be disabled")
+	}
+	if w := postResetRequest(srv, "alice"); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %v", w.Code)
+	}
+	srv.state.background.Wait()
+	if got := sender.sent(); len(got) != 0 {
+		t.Fatalf("expected no mails, got %v", got)
+	}
+}
+
+func TestPasswordResetRequestsAreThrottledWithoutBruteForceProtection(t *testing.T) {
+	d, _ := db.NewMemoryDatabase()
+	srv, _ := newResetTestServer(t, d)
+	defer srv.state.background.Wait()
+	for i := 0; i < 5; i++ {
+		if w := postResetRequest(srv, "alice"); strings.Contains(w.Body.String(), "Too many") {
+			return
+		}
+	}
+	t.Fatal("expected the requests to be throttled")
+}
+
+// createPasswordReset stores a password reset request
+// for the given user and returns its token.
+func createPasswordReset(t *testing.T, srv *Server, userID string, random string) string {
+	t.Helper()
+	token, err := srv.signToken("password-reset", time.Hour, random)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = srv.Database().CreatePasswordReset(context.Background(), &db.PasswordReset{
+		ID:        resetID(random),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestPasswordResetInvalidatesOtherLinks(t *testing.T) {
+	d, _ := db.NewMemoryDatabase()
+	srv, _ := newResetTestServer(t, d)
+	u, err := d.FindUserByUsernameOrMail(context.Background(), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := createPasswordReset(t, srv, u.Subject(), "first")
+	second := createPasswordReset(t, srv, u.Subject(), "second")
+	for i, token := range []string{first, second} {
+		form := url.Values{"token": {token}, "password": {"new-password"}, "confirm": {"new-password"}}
+		r := httptest.NewRequest(http.MethodPost, "/password-reset", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		srv.GetPasswordResetHandler()(w, r)
+		changed := strings.Contains(w.Body.String(), "Your password has been changed.")
+		if changed != (i == 0) {
+			t.Fatalf("link %v: expected the password to be changed %v, got %v", i+1, i == 0, changed)
+		}
+	}
+}
@@ -2,23 +2,70 @@ package godra
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
-	"os"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/rbicker/godra/internal/db"
 	"github.com/rbicker/godra/internal/hydraclient"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/mail"
+	"github.com/rbicker/godra/internal/metrics"
+	"github.com/rbicker/godra/internal/throttle"
 	"github.com/rbicker/nogo"
 )
 
 // Server represents an api server.
 type Server struct {
 	port            int
-	httpServer      *http.Server
 	db              db.Database
 	hydraPrivateURL string
 	hydraclient     hydraclient.Client
+	secret          []byte
+	totpIssuer      string
+	webauthn        *webauthn.WebAuthn
+	throttler       *throttle.Throttler
+	resetThrottler  *throttle.Throttler
+	trustedProxies  []*net.IPNet
+	mailSender      mail.Sender
+	publicURL       string
+	resetTTL        time.Duration
+	adminPort       int
+	adminToken      string
+	consentPrompt   bool
+	trustedClients  map[string]bool
+	scopes          map[string]Scope
+	claims          ClaimsConfig
+	policy          *Policy
+	shutdownDelay   time.Duration
+	shutdownTimeout time.Duration
+	metrics         bool
+	metricsPort     int
+	customization   Customization
+	tlsConfig       *tls.Config
+	adminTLSConfig  *tls.Config
+	redirectPort    int
+	state           *serverState
+}
+
+// serverState is shared by all copies of the server
+// and tracks the running http servers.
+type serverState struct {
+	mu       sync.Mutex
+	draining bool
+	servers  []*http.Server
+	// background tracks the running background tasks,
+	// such as sending mails.
+	background sync.WaitGroup
 }
 
 // NewServer creates a new api server.
@@ -31,6 +78,15 @@ func NewServer(opts ...func(*Server) error) (*Server, error) {
 	var srv = Server{
 		port:            5000,
 		hydraPrivateURL: "http://127.0.0.1:4445",
+		totpIssuer:      "godra",
+		publicURL:       "http://localhost:5000",
+		resetTTL:        time.Hour,
+		scopes:          make(map[string]Scope),
+		shutdownTimeout: 30 * time.Second,
+		state:           &serverState{},
+	}
+	for name, scope := range defaultScopes {
+		srv.scopes[name] = scope
 	}
 	// run functional options
 	for _, op := range opts {
@@ -46,28 +102,181 @@ func NewServer(opts ...func(*Server) error) (*Server, error) {
 		}
 		srv.db = db
 	}
+	// password reset requests are limited even
+	// if the brute-force protection is disabled
+	srv.resetThrottler = srv.throttler
+	if srv.resetThrottler == nil && srv.mailSender != nil {
+		t, err := throttle.New(srv.db)
+		if err != nil {
+			return nil, fmt.Errorf("creating password reset throttle failed: %w", err)
+		}
+		srv.resetThrottler = t
+	}
+	if srv.secret == nil {
+		slog.Warn("no secret configured, using a random one - tokens will not be valid across restarts or replicas")
+		srv.secret = make([]byte, 32)
+		if _, err := rand.Read(srv.secret); err != nil {
+			return nil, fmt.Errorf("generating random secret failed: %w", err)
+		}
+	}
 	return &srv, nil
 }
 
-// Serve starts the http server.
-func (srv Server) Serve() error {
+// Serve starts the http server, serving https if tls is enabled.
+// After Shutdown, it returns http.ErrServerClosed.
+func (srv *Server) Serve() error {
 	m := http.NewServeMux()
-	if static, ok := os.LookupEnv("CUSTOM_STATIC_PATH"); ok {
+	// handle registers the handler, recording the request durations
+	handle := func(pattern string, h http.HandlerFunc) {
+		m.Handle(pattern, metrics.InstrumentHandler(pattern, h))
+	}
+	if static := srv.customization.StaticPath; static != "" {
 		m.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir(static))))
 	}
 	m.Handle("/public/", http.StripPrefix("/public/", http.FileServer(nogo.Dir("/assets/public"))))
-	m.HandleFunc("/login", srv.GetLoginHandler())
-	m.HandleFunc("/consent", srv.GetConsentHandler())
-	m.HandleFunc("/logout", srv.GetLogoutHandler())
-	srv.httpServer = &http.Server{Addr: fmt.Sprintf(":%v", srv.port), Handler: m}
-	return srv.httpServer.ListenAndServe()
+	handle("/login", srv.GetLoginHandler())
+	handle("/login/totp", srv.GetTOTPHandler())
+	if srv.enrollmentEnabled() {
+		handle("/totp", srv.GetTOTPEnrollHandler())
+	}
+	if srv.webauthn != nil {
+		handle("/login/webauthn/begin", srv.GetWebAuthnLoginBeginHandler())
+		handle("/login/webauthn/finish", srv.GetWebAuthnLoginFinishHandler())
+		if srv.enrollmentEnabled() {
+			handle("/webauthn", srv.GetWebAuthnEnrollHandler())
+			handle("/webauthn/begin", srv.GetWebAuthnRegisterBeginHandler())
+			handle("/webauthn/finish", srv.GetWebAuthnRegisterFinishHandler())
+		}
+	}
+	if srv.passwordResetEnabled() {
+		handle("/password-reset", srv.GetPasswordResetHandler())
+	}
+	handle("/consent", srv.GetConsentHandler())
+	handle("/logout", srv.GetLogoutHandler())
+	handle("/healthz", srv.GetHealthHandler())
+	handle("/readyz", srv.GetReadinessHandler())
+	if srv.metrics && srv.metricsPort == 0 {
+		m.Handle("/metrics", metrics.Handler())
+	}
+	return srv.listen(&http.Server{Addr: fmt.Sprintf(":%v", srv.port), Handler: logging.Middleware(m), TLSConfig: srv.tlsConfig})
+}
+
+// ServeAdmin starts the http server for the admin api.
+// If a client ca is configured, it serves https and
+// requires client certificates.
+// It returns an error if the admin api is not enabled.
+// After Shutdown, it returns http.ErrServerClosed.
+func (srv *Server) ServeAdmin() error {
+	if srv.adminToken == "" {
+		return fmt.Errorf("admin api is not enabled")
+	}
+	return srv.listen(&http.Server{Addr: fmt.Sprintf(":%v", srv.adminPort), Handler: logging.Middleware(srv.GetAdminHandler()), TLSConfig: srv.adminTLSConfig})
+}
+
+// ServeMetrics starts the http server for the metrics.
+// It returns an error if the metrics are not enabled
+// or served by the http server itself.
+// After Shutdown, it returns http.ErrServerClosed.
+func (srv *Server) ServeMetrics() error {
+	if !srv.metrics || srv.metricsPort == 0 {
+		return fmt.Errorf("metrics are not served on a separate port")
+	}
+	m := http.NewServeMux()
+	m.Handle("/metrics", metrics.Handler())
+	return srv.listen(&http.Server{Addr: fmt.Sprintf(":%v", srv.metricsPort), Handler: m})
 }
 
-// Shutdown stops the http server gracefully.
-func (srv Server) Shutdown() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+// listen keeps track of the given http server, so it
+// can be shut down, and starts it. Servers with a tls
+// configuration serve https.
+func (srv *Server) listen(s *http.Server) error {
+	srv.state.mu.Lock()
+	if srv.state.draining {
+		srv.state.mu.Unlock()
+		return http.ErrServerClosed
+	}
+	srv.state.servers = append(srv.state.servers, s)
+	srv.state.mu.Unlock()
+	if s.TLSConfig != nil {
+		return s.ListenAndServeTLS("", "")
+	}
+	return s.ListenAndServe()
+}
+
+// Shutdown stops the http servers gracefully.
+// The readiness check fails from now on. After the shutdown delay,
+// the servers stop accepting connections and the in-flight requests
+// are given the shutdown timeout to complete.
+func (srv *Server) Shutdown() error {
+	srv.state.mu.Lock()
+	srv.state.draining = true
+	srv.state.mu.Unlock()
+	if srv.shutdownDelay > 0 {
+		slog.Info("waiting before shutting down", "delay", srv.shutdownDelay)
+		time.Sleep(srv.shutdownDelay)
+	}
+	srv.state.mu.Lock()
+	servers := srv.state.servers
+	srv.state.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), srv.shutdownTimeout)
 	defer cancel()
-	return srv.httpServer.Shutdown(ctx)
+	var errs []error
+	for _, s := range servers {
+		if err := s.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		srv.state.background.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("background tasks did not complete: %w", ctx.Err()))
+	}
+	return errors.Join(errs...)
+}
+
+// background runs the given function in a new goroutine.
+// Shutdown waits for it to complete.
+func (srv Server) background(f func()) {
+	srv.state.background.Add(1)
+	go func() {
+		defer srv.state.background.Done()
+		f()
+	}()
+}
+
+// passwordResetEnabled returns true if users can reset their password,
+// which requires a mail sender and a database allowing to change users.
+func (srv Server) passwordResetEnabled() bool {
+	return srv.mailSender != nil && !db.IsReadOnly(srv.Database())
+}
+
+// enrollmentEnabled returns true if users can enroll a totp device
+// or passkeys, which requires a database allowing to change users.
+func (srv Server) enrollmentEnabled() bool {
+	return !db.IsReadOnly(srv.Database())
+}
+
+// throttles returns the throttler managing the stored throttle keys,
+// which is the login throttler or, if the brute-force protection
+// is disabled, the throttler of the password reset requests.
+// It returns nil if nothing is throttled.
+func (srv Server) throttles() *throttle.Throttler {
+	if srv.throttler != nil {
+		return srv.throttler
+	}
+	return srv.resetThrottler
+}
+
+// draining returns true if the server is shutting down.
+func (srv Server) draining() bool {
+	srv.state.mu.Lock()
+	defer srv.state.mu.Unlock()
+	return srv.state.draining
 }
 
 // Database returns the database connection.
@@ -93,6 +302,33 @@ func SetPort(port int) func(*Server) error {
 	}
 }
 
+// SetShutdownTimeout changes how long in-flight requests
+// are given to complete when shutting down.
+// The default is 30 seconds.
+func SetShutdownTimeout(timeout time.Duration) func(*Server) error {
+	return func(srv *Server) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid shutdown timeout: %v", timeout)
+		}
+		srv.shutdownTimeout = timeout
+		return nil
+	}
+}
+
+// SetShutdownDelay sets how long the servers keep accepting
+// connections with a failing readiness check when shutting down,
+// so load balancers can stop sending new requests first.
+// There is no delay by default.
+func SetShutdownDelay(delay time.Duration) func(*Server) error {
+	return func(srv *Server) error {
+		if delay < 0 {
+			return fmt.Errorf("invalid shutdown delay: %v", delay)
+		}
+		srv.shutdownDelay = delay
+		return nil
+	}
+}
+
 // SetDatabase sets the given db provider.
 // A mongodb provider will be used by default
 func SetDatabase(db db.Database) func(*Server) error {
@@ -109,3 +345,233 @@ func SetHydraClient(client hydraclient.Client) func(*Server) error {
 		return nil
 	}
 }
+
+// SetSecret sets the secret which is used to sign tokens,
+// such as the one proving that a user's password was validated
+// before asking for the second factor.
+// All replicas need to use the same secret.
+// If no secret is set, a random one will be generated.
+func SetSecret(secret string) func(*Server) error {
+	return func(srv *Server) error {
+		if len(secret) < 32 {
+			return fmt.Errorf("secret needs to be at least 32 characters long")
+		}
+		srv.secret = []byte(secret)
+		return nil
+	}
+}
+
+// SetTOTPIssuer sets the issuer name which is shown
+// in the user's authenticator app.
+// The default issuer is "godra".
+func SetTOTPIssuer(issuer string) func(*Server) error {
+	return func(srv *Server) error {
+		if issuer == "" {
+			return fmt.Errorf("empty totp issuer given")
+		}
+		srv.totpIssuer = issuer
+		return nil
+	}
+}
+
+// SetWebAuthn enables passkey logins using webauthn.
+// The relying party id is the domain under which godra is served,
+// the origins are the full urls (including scheme and port)
+// from which the login page is accessed.
+// Webauthn is disabled by default.
+func SetWebAuthn(rpID string, rpDisplayName string, rpOrigins []string) func(*Server) error {
+	return func(srv *Server) error {
+		wa, err := webauthn.New(&webauthn.Config{
+			RPID:          rpID,
+			RPDisplayName: rpDisplayName,
+			RPOrigins:     rpOrigins,
+		})
+		if err != nil {
+			return fmt.Errorf("invalid webauthn configuration: %w", err)
+		}
+		srv.webauthn = wa
+		return nil
+	}
+}
+
+// SetThrottler enables throttling of failed login attempts
+// using the given throttler.
+// Throttling is disabled by default.
+func SetThrottler(t *throttle.Throttler) func(*Server) error {
+	return func(srv *Server) error {
+		srv.throttler = t
+		return nil
+	}
+}
+
+// SetTrustedProxies sets the networks (in CIDR notation) of the
+// proxies whose X-Forwarded-For header is used to determine
+// the client's ip address. Single ip addresses are accepted as well.
+func SetTrustedProxies(cidrs []string) func(*Server) error {
+	return func(srv *Server) error {
+		var nets []*net.IPNet
+		for _, c := range cidrs {
+			c = strings.TrimSpace(c)
+			if c == "" {
+				continue
+			}
+			if !strings.Contains(c, "/") {
+				if ip := net.ParseIP(c); ip != nil && ip.To4() != nil {
+					c += "/32"
+				} else {
+					c += "/128"
+				}
+			}
+			_, n, err := net.ParseCIDR(c)
+			if err != nil {
+				return fmt.Errorf("invalid trusted proxy '%s': %w", c, err)
+			}
+			nets = append(nets, n)
+		}
+		srv.trustedProxies = nets
+		return nil
+	}
+}
+
+// SetMailSender enables the self-service password reset,
+// using the given sender to deliver the reset links.
+// The password reset is disabled by default.
+func SetMailSender(sender mail.Sender) func(*Server) error {
+	return func(srv *Server) error {
+		srv.mailSender = sender
+		return nil
+	}
+}
+
+// SetPublicURL sets the url under which godra is reachable
+// by the users. It is used to create the links in mails.
+// The default url is "http://localhost:5000".
+func SetPublicURL(u string) func(*Server) error {
+	return func(srv *Server) error {
+		parsed, err := url.Parse(u)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("invalid public url: %s", u)
+		}
+		srv.publicURL = u
+		return nil
+	}
+}
+
+// SetPasswordResetTTL changes how long password reset links are valid.
+// The default is one hour.
+func SetPasswordResetTTL(ttl time.Duration) func(*Server) error {
+	return func(srv *Server) error {
+		if ttl <= 0 {
+			return fmt.Errorf("invalid password reset ttl: %v", ttl)
+		}
+		srv.resetTTL = ttl
+		return nil
+	}
+}
+
+// SetAdminAPI enables the admin api for user management,
+// listening on the given port. Requests need to carry the
+// given token, which needs to be at least 32 characters long,
+// as bearer token.
+// The admin api is disabled by default.
+func SetAdminAPI(port int, token string) func(*Server) error {
+	return func(srv *Server) error {
+		if port <= 0 {
+			return fmt.Errorf("invalid admin port number: %v", port)
+		}
+		if len(token) < 32 {
+			return fmt.Errorf("admin token needs to be at least 32 characters long")
+		}
+		srv.adminPort = port
+		srv.adminToken = token
+		return nil
+	}
+}
+
+// SetMetrics enables the prometheus metrics under /metrics.
+// They are served on the given port, or by the
+// http server itself if the port is 0.
+// The metrics are disabled by default.
+func SetMetrics(port int) func(*Server) error {
+	return func(srv *Server) error {
+		if port < 0 {
+			return fmt.Errorf("invalid metrics port number: %v", port)
+		}
+		srv.metrics = true
+		srv.metricsPort = port
+		return nil
+	}
+}
+
+// SetConsentPrompt enables the consent page, which lets users
+// choose the scopes granted to a client. Consent for the given
+// trusted (first-party) clients is still given automatically.
+// By default, consent is given automatically for all clients.
+func SetConsentPrompt(trustedClients []string) func(*Server) error {
+	return func(srv *Server) error {
+		srv.consentPrompt = true
+		srv.trustedClients = make(map[string]bool)
+		for _, c := range trustedClients {
+			if c = strings.TrimSpace(c); c != "" {
+				srv.trustedClients[c] = true
+			}
+		}
+		return nil
+	}
+}
+
+// SetScopes sets the descriptions of scopes shown on the consent page.
+// They are merged with the defaults for well-known scopes such as "openid".
+func SetScopes(scopes map[string]Scope) func(*Server) error {
+	return func(srv *Server) error {
+		for name, scope := range scopes {
+			if name == "" {
+				return fmt.Errorf("empty scope name given")
+			}
+			srv.scopes[name] = scope
+		}
+		return nil
+	}
+}
+
+// SetClaims sets the mapping which renames claims or
+// adds static ones to the tokens, for all or specific clients.
+// Claims set by hydra itself, such as "sub", cannot be changed.
+func SetClaims(cfg ClaimsConfig) func(*Server) error {
+	return func(srv *Server) error {
+		if err := cfg.Default.validate(); err != nil {
+			return fmt.Errorf("invalid default claim mapping: %w", err)
+		}
+		for client, m := range cfg.Clients {
+			if err := m.validate(); err != nil {
+				return fmt.Errorf("invalid claim mapping for client '%s': %w", client, err)
+			}
+		}
+		srv.claims = cfg
+		return nil
+	}
+}
+
+// SetPolicy sets the policy defining which roles
+// are required to log in to which clients.
+// By default, all users can log in to all clients.
+func SetPolicy(p Policy) func(*Server) error {
+	return func(srv *Server) error {
+		for client := range p.Clients {
+			if client == "" {
+				return fmt.Errorf("empty client id given in policy")
+			}
+		}
+		srv.policy = &p
+		return nil
+	}
+}
+
+// SetCustomization sets the custom static files,
+// stylesheet and html snippets used to brand the pages.
+func SetCustomization(c Customization) func(*Server) error {
+	return func(srv *Server) error {
+		srv.customization = c
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package godra
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+// throttled registers a login attempt for the given keys and checks
+// if logins using any of them are blocked because of too many failed
+// attempts. If so, the message which should be shown to the user is returned.
+// The attempt counts as failed unless releaseAttempt or
+// loginSucceeded is called, so parallel attempts cannot bypass the throttle.
+// Errors are logged and do not block the login.
+func (srv Server) throttled(ctx context.Context, keys ...string) (string, bool) {
+	return attempt(ctx, srv.throttler, keys...)
+}
+
+// attempt registers an attempt for the given keys using the given
+// throttler, like throttled. Nothing is throttled if it is nil.
+func attempt(ctx context.Context, t *throttle.Throttler, keys ...string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	wait, err := t.Attempt(ctx, keys...)
+	if err != nil {
+		slog.ErrorContext(ctx, "error while checking login throttle", "error", err)
+		return "", false
+	}
+	if wait <= 0 {
+		return "", false
+	}
+	return throttle.Message(wait), true
+}
+
+// releaseAttempt releases the login attempts registered for the
+// given keys, because they succeeded or could not be checked,
+// so they do not count as failed.
+func (srv Server) releaseAttempt(ctx context.Context, keys ...string) {
+	if srv.throttler == nil {
+		return
+	}
+	if err := srv.throttler.Release(ctx, keys...); err != nil {
+		slog.ErrorContext(ctx, "error while releasing login attempt", "error", err)
+	}
+}
+
+// loginSucceeded resets the failed login attempts for the given keys.
+func (srv Server) loginSucceeded(ctx context.Context, keys ...string) {
+	if srv.throttler == nil {
+		return
+	}
+	if err := srv.throttler.Reset(ctx, keys...); err != nil {
+		slog.ErrorContext(ctx, "error while resetting login throttle", "error", err)
+	}
+}
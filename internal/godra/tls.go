@@ -0,0 +1,232 @@
+package godra
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// certCheckInterval limits how often the certificate
+// files are checked for changes.
+const certCheckInterval = 10 * time.Second
+
+// tlsVersions maps the supported minimum tls versions to their ids.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSConfig describes how the login server serves https.
+type TLSConfig struct {
+	// CertFile and KeyFile are the pem encoded certificate
+	// (chain) and private key. They are reloaded when
+	// they change on disk.
+	CertFile string
+	KeyFile  string
+	// MinVersion is the minimum tls version, 1.0, 1.1, 1.2 or 1.3.
+	// Defaults to 1.2.
+	MinVersion string
+	// CipherSuites are the names of the cipher suites enabled for
+	// tls 1.2 and below, such as TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+	// Defaults to go's secure cipher suites.
+	CipherSuites []string
+	// ClientCAFile is a pem encoded ca certificate. If set, the admin
+	// api is served using https as well and its clients need to present
+	// a certificate signed by it (mutual tls). The login server does not
+	// ask for client certificates, as browsers and probes have none.
+	ClientCAFile string
+}
+
+// certReloader loads the certificate from its files
+// and reloads it after the files have changed.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	modTime  time.Time
+	checked  time.Time
+}
+
+// newCertReloader loads the certificate from the given files.
+func newCertReloader(certFile string, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	modTime, err := r.lastModified()
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load tls certificate: %w", err)
+	}
+	r.cert, r.modTime, r.checked = &cert, modTime, time.Now()
+	return r, nil
+}
+
+// lastModified returns the latest modification time of the files.
+func (r *certReloader) lastModified() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to read tls certificate: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// GetCertificate returns the current certificate. The files are
+// checked for changes at most once per certCheckInterval. If the
+// changed files cannot be loaded, the previous certificate is kept.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < certCheckInterval {
+		return r.cert, nil
+	}
+	r.checked = time.Now()
+	modTime, err := r.lastModified()
+	if err != nil {
+		slog.Error("error while checking tls certificate, keeping the current one", "error", err)
+		return r.cert, nil
+	}
+	if modTime.Equal(r.modTime) {
+		return r.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		// the files may be in the middle of being replaced, retry next time
+		slog.Error("error while reloading tls certificate, keeping the current one", "error", err)
+		return r.cert, nil
+	}
+	r.cert, r.modTime = &cert, modTime
+	slog.Info("reloaded tls certificate", "path", r.certFile)
+	return r.cert, nil
+}
+
+// newTLSConfig creates the tls configuration of the login server.
+func newTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("tls certificate and key file need to be given")
+	}
+	reloader, err := newCertReloader(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	c := &tls.Config{
+		GetCertificate: reloader.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+	if cfg.MinVersion != "" {
+		v, ok := tlsVersions[cfg.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid minimum tls version '%s', expected 1.0, 1.1, 1.2 or 1.3", cfg.MinVersion)
+		}
+		c.MinVersion = v
+	}
+	if len(cfg.CipherSuites) > 0 {
+		ids := make(map[string]uint16)
+		for _, s := range tls.CipherSuites() {
+			ids[s.Name] = s.ID
+		}
+		for _, name := range cfg.CipherSuites {
+			id, ok := ids[strings.TrimSpace(name)]
+			if !ok {
+				return nil, fmt.Errorf("unknown or insecure cipher suite '%s'", name)
+			}
+			c.CipherSuites = append(c.CipherSuites, id)
+		}
+	}
+	return c, nil
+}
+
+// newClientAuthTLSConfig returns a copy of the given tls configuration,
+// which requires clients to present a certificate signed by the
+// ca certificate in the given file.
+func newClientAuthTLSConfig(c *tls.Config, clientCAFile string) (*tls.Config, error) {
+	b, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client ca file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in client ca file %s", clientCAFile)
+	}
+	c = c.Clone()
+	c.ClientCAs = pool
+	c.ClientAuth = tls.RequireAndVerifyClientCert
+	return c, nil
+}
+
+// SetTLS enables https for the login server using the given configuration.
+// If a client ca file is given, the admin api is served using https
+// and requires client certificates.
+// The certificate is loaded right away, so invalid files are reported early.
+func SetTLS(cfg TLSConfig) func(*Server) error {
+	return func(srv *Server) error {
+		c, err := newTLSConfig(cfg)
+		if err != nil {
+			return err
+		}
+		srv.tlsConfig = c
+		srv.adminTLSConfig = nil
+		if cfg.ClientCAFile != "" {
+			if srv.adminTLSConfig, err = newClientAuthTLSConfig(c, cfg.ClientCAFile); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// SetHTTPRedirect enables the http listener on the given
+// port, which redirects all requests to https.
+func SetHTTPRedirect(port int) func(*Server) error {
+	return func(srv *Server) error {
+		if port <= 0 {
+			return fmt.Errorf("invalid http redirect port number: %v", port)
+		}
+		srv.redirectPort = port
+		return nil
+	}
+}
+
+// ServeRedirect starts the http server redirecting to https.
+// It returns an error if https or the redirect is not enabled.
+// After Shutdown, it returns http.ErrServerClosed.
+func (srv *Server) ServeRedirect() error {
+	if srv.tlsConfig == nil || srv.redirectPort == 0 {
+		return fmt.Errorf("http to https redirect is not enabled")
+	}
+	return srv.listen(&http.Server{Addr: fmt.Sprintf(":%v", srv.redirectPort), Handler: srv.GetRedirectHandler()})
+}
+
+// GetRedirectHandler returns the handler redirecting
+// all requests to the https port of the login server.
+func (srv Server) GetRedirectHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = strings.Trim(r.Host, "[]")
+		}
+		if srv.port != 443 {
+			host = net.JoinHostPort(host, fmt.Sprint(srv.port))
+		} else if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		target := "https://" + host + r.URL.RequestURI()
+		http.Redirect(w, r, target, http.StatusPermanentRedirect)
+	}
+}
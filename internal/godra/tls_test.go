@@ -0,0 +1,81 @@
+package godra
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertificate writes a self-signed certificate and its key
+// to the given directory and returns the paths of the files.
+func writeCertificate(t *testing.T, dir string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestSetTLSClientCA(t *testing.T) {
+	certFile, keyFile := writeCertificate(t, t.TempDir())
+	tests := []struct {
+		name         string
+		clientCAFile string
+		wantAdminTLS bool
+	}{
+		{name: "without client ca"},
+		{name: "with client ca", clientCAFile: certFile, wantAdminTLS: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := NewServer(SetTLS(TLSConfig{CertFile: certFile, KeyFile: keyFile, ClientCAFile: tt.clientCAFile}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if srv.tlsConfig == nil || srv.tlsConfig.ClientAuth != tls.NoClientCert {
+				t.Fatal("expected the login server to serve https without client certificates")
+			}
+			if (srv.adminTLSConfig != nil) != tt.wantAdminTLS {
+				t.Fatalf("expected admin tls %v, got %v", tt.wantAdminTLS, srv.adminTLSConfig != nil)
+			}
+			if tt.wantAdminTLS && srv.adminTLSConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+				t.Fatal("expected the admin api to require client certificates")
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package godra
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// token represents the payload of a signed token.
+type token struct {
+	Purpose string   `json:"p"`
+	Values  []string `json:"v"`
+	Expires int64    `json:"e"`
+}
+
+// sign calculates the hmac of the given payload using the server's secret.
+func (srv Server) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, srv.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// signToken creates a token containing the given values
+// which is valid for the given duration.
+// The token is signed using the server's secret,
+// it is not encrypted.
+// The purpose prevents tokens from being used in another flow.
+func (srv Server) signToken(purpose string, ttl time.Duration, values ...string) (string, error) {
+	b, err := json.Marshal(token{
+		Purpose: purpose,
+		Values:  values,
+		Expires: time.Now().Add(ttl).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not encode token: %w", err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(srv.sign(payload)), nil
+}
+
+// verifyToken verifies the signature, the purpose and the expiry
+// of the given token and returns the contained values.
+func (srv Server) verifyToken(purpose string, s string) ([]string, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("malformed token")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode token signature: %w", err)
+	}
+	if !hmac.Equal(sig, srv.sign(parts[0])) {
+		return nil, fmt.Errorf("invalid token signature")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode token payload: %w", err)
+	}
+	var t token
+	if err = json.Unmarshal(b, &t); err != nil {
+		return nil, fmt.Errorf("could not decode token payload: %w", err)
+	}
+	if t.Purpose != purpose {
+		return nil, fmt.Errorf("token was issued for '%s' instead of '%s'", t.Purpose, purpose)
+	}
+	if time.Now().Unix() > t.Expires {
+		return nil, fmt.Errorf("token expired")
+	}
+	return t.Values, nil
+}
@@ -0,0 +1,57 @@
+package godra
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToken(t *testing.T) {
+	srv := Server{secret: []byte("0123456789abcdef0123456789abcdef")}
+	valid, err := srv.signToken("totp", time.Minute, "challenge", "subject")
+	if err != nil {
+		t.Fatalf("signing token failed: %v", err)
+	}
+	expired, err := srv.signToken("totp", -time.Minute, "challenge", "subject")
+	if err != nil {
+		t.Fatalf("signing token failed: %v", err)
+	}
+	payload, sig, _ := strings.Cut(valid, ".")
+	other, _ := srv.signToken("totp", time.Minute, "challenge", "attacker")
+	otherPayload, _, _ := strings.Cut(other, ".")
+	tests := []struct {
+		name    string
+		purpose string
+		token   string
+		wantErr string
+	}{
+		{name: "valid", purpose: "totp", token: valid},
+		{name: "expired", purpose: "totp", token: expired, wantErr: "expired"},
+		{name: "wrong purpose", purpose: "totp-enroll", token: valid, wantErr: "issued for 'totp'"},
+		{name: "tampered payload", purpose: "totp", token: otherPayload + "." + sig, wantErr: "invalid token signature"},
+		{name: "tampered signature", purpose: "totp", token: payload + "." + sig[:len(sig)-2] + "AA", wantErr: "invalid token signature"},
+		{name: "other secret", purpose: "totp", token: func() string {
+			s, _ := Server{secret: []byte("another secret")}.signToken("totp", time.Minute, "challenge", "subject")
+			return s
+		}(), wantErr: "invalid token signature"},
+		{name: "malformed", purpose: "totp", token: payload, wantErr: "malformed"},
+		{name: "empty", purpose: "totp", token: "", wantErr: "malformed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := srv.verifyToken(tt.purpose, tt.token)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing '%s', got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(values) != 2 || values[0] != "challenge" || values[1] != "subject" {
+				t.Fatalf("unexpected values: %v", values)
+			}
+		})
+	}
+}
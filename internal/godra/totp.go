@@ -0,0 +1,370 @@
+package godra
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"image/png"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/metrics"
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+// duration for which the token, proving that the
+// password was already validated, is accepted.
+const totpTokenTTL = 5 * time.Minute
+
+// duration for which an enrollment has to be confirmed.
+const totpEnrollTokenTTL = 10 * time.Minute
+
+// maxTOTPAttempts limits the invalid one-time passwords which can be
+// entered for a login request, even if the throttler is disabled.
+// Afterwards, the login request is rejected.
+const maxTOTPAttempts = 5
+
+// errInvalidTOTP is returned if a one-time password is wrong or was already used.
+var errInvalidTOTP = errors.New("invalid one-time password")
+
+// checkTOTP validates the one-time password of the user and stores
+// its time step, so the same password cannot be used again.
+// Wrong and reused passwords return an error wrapping errInvalidTOTP.
+func (srv Server) checkTOTP(ctx context.Context, u *db.User, code string) error {
+	step, err := u.ValidateTOTP(code)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidTOTP, err)
+	}
+	err = srv.Database().UpdateTOTPStep(ctx, u.Subject(), step)
+	if errors.Is(err, db.ErrTOTPReplayed) {
+		return fmt.Errorf("%w: %v", errInvalidTOTP, err)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to store totp step: %w", err)
+	}
+	return nil
+}
+
+// renderTOTPForm renders the form asking for the totp code.
+func (srv Server) renderTOTPForm(w http.ResponseWriter, challenge string, token string, alert string) {
+	inputs := struct {
+		Challenge  string
+		Token      string
+		Alert      string
+		Stylesheet template.HTML
+	}{
+		Challenge:  challenge,
+		Token:      token,
+		Alert:      alert,
+		Stylesheet: srv.stylesheet(),
+	}
+	srv.renderTemplate(w, "totp", inputs)
+}
+
+// GetTOTPHandler returns the handler for the /login/totp route.
+// The POST request expects to receive the token which was
+// issued after the password was validated, as well as the
+// totp code. If the code is valid, the login gets accepted.
+func (srv Server) GetTOTPHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		err := r.ParseForm()
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error parsing form in totp post request", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		submit, challenge, token, code := r.FormValue("submit"), r.FormValue("challenge"), r.FormValue("token"), r.FormValue("code")
+		r = r.WithContext(logging.With(r.Context(), "login_challenge", challenge))
+		if submit == "cancel" {
+			slog.InfoContext(r.Context(), "login cancelled")
+			metrics.LoginAttempt(metrics.LoginCancelled)
+			reject(w, r, srv, challenge, "cancelled", "login was cancelled by the user")
+			return
+		}
+		values, err := srv.verifyToken("totp", token)
+		if err != nil || len(values) != 2 || values[0] != challenge {
+			srv.renderLoginForm(w, r, challenge, "Your login has expired, please try again.")
+			return
+		}
+		if code == "" {
+			srv.renderTOTPForm(w, challenge, token, "Code not set.")
+			return
+		}
+		u, err := srv.Database().FindUserByID(r.Context(), values[1])
+		if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+			slog.ErrorContext(r.Context(), "error while searching user", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if err != nil {
+			srv.renderLoginForm(w, r, challenge, "User not found.")
+			return
+		}
+		r = r.WithContext(logging.With(r.Context(), "subject", u.Subject()))
+		errorID, msg, err := srv.checkLogin(r.Context(), challenge, u)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while checking login", "error", err)
+			srv.renderHydraError(w, "login", challenge, err)
+			return
+		}
+		if errorID != "" {
+			rejectWithMessage(w, r, srv, challenge, errorID, msg)
+			return
+		}
+		ipKey, userKey := throttle.IPKey(srv.clientIP(r)), throttle.UserKey(u.Subject())
+		if msg, blocked := srv.throttled(r.Context(), ipKey, userKey); blocked {
+			srv.renderTOTPForm(w, challenge, token, msg)
+			return
+		}
+		// count the attempt before checking the code,
+		// so parallel requests cannot exceed the limit
+		attemptsKey := throttle.TOTPKey(challenge)
+		attempts, err := srv.Database().IncrementLoginFailures(r.Context(), attemptsKey)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while counting one-time password attempts", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if attempts.Failures > maxTOTPAttempts {
+			srv.rejectTOTPAttempts(w, r, challenge)
+			return
+		}
+		if err = srv.checkTOTP(r.Context(), u, code); err != nil {
+			if !errors.Is(err, errInvalidTOTP) {
+				srv.releaseAttempt(r.Context(), ipKey, userKey)
+				slog.ErrorContext(r.Context(), "error while checking one-time password", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			slog.InfoContext(r.Context(), "login failed, invalid one-time password")
+			if attempts.Failures >= maxTOTPAttempts {
+				srv.rejectTOTPAttempts(w, r, challenge)
+				return
+			}
+			srv.renderTOTPForm(w, challenge, token, "Invalid code.")
+			return
+		}
+		if err = srv.Database().DeleteLoginThrottle(r.Context(), attemptsKey); err != nil {
+			slog.ErrorContext(r.Context(), "error while resetting one-time password attempts", "error", err)
+		}
+		srv.releaseAttempt(r.Context(), ipKey)
+		srv.loginSucceeded(r.Context(), userKey)
+		metrics.LoginAttempt(metrics.LoginSuccess)
+		accept(w, r, srv, challenge, u.Subject(), "", []string{"pwd", "otp", "mfa"})
+	}
+}
+
+// rejectTOTPAttempts rejects the login request after too many
+// invalid one-time passwords and removes the counted attempts.
+// The attempts are removed after the rejection, as hydra
+// does not accept a rejected login request anymore.
+func (srv Server) rejectTOTPAttempts(w http.ResponseWriter, r *http.Request, challenge string) {
+	rejectWithMessage(w, r, srv, challenge, "too_many_attempts", "Too many invalid codes, please sign in again.")
+	if err := srv.Database().DeleteLoginThrottle(r.Context(), throttle.TOTPKey(challenge)); err != nil {
+		slog.ErrorContext(r.Context(), "error while resetting one-time password attempts", "error", err)
+	}
+}
+
+// renderTOTPEnrollForm renders the totp enrollment page.
+// If a qr code is given, the page asks to confirm the enrollment.
+func (srv Server) renderTOTPEnrollForm(w http.ResponseWriter, inputs totpEnrollInputs) {
+	inputs.Stylesheet = srv.stylesheet()
+	srv.renderTemplate(w, "totp-enroll", inputs)
+}
+
+// inputs for the totp enrollment page.
+type totpEnrollInputs struct {
+	Token      string
+	QRCode     template.URL
+	Secret     string
+	Alert      string
+	Info       string
+	Stylesheet template.HTML
+}
+
+// GetTOTPEnrollHandler returns the handler for the /totp route.
+// The GET request shows a form asking for the user's credentials.
+// The POST request validates the credentials and shows a qr code
+// containing a newly generated totp secret. Once the user confirms
+// the enrollment by entering a valid code, the secret gets saved.
+func (srv Server) GetTOTPEnrollHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !srv.enrollmentEnabled() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "GET":
+			srv.renderTOTPEnrollForm(w, totpEnrollInputs{})
+		case "POST":
+			err := r.ParseForm()
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error parsing form in totp enroll post request", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if r.FormValue("token") != "" {
+				handleTOTPEnrollConfirm(w, r, srv)
+				return
+			}
+			handleTOTPEnroll(w, r, srv)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// handleTOTPEnroll validates the user's credentials
+// and generates a new totp secret.
+func handleTOTPEnroll(w http.ResponseWriter, r *http.Request, srv Server) {
+	username, password := r.FormValue("username"), r.FormValue("password")
+	if username == "" || password == "" {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Username or Password not set."})
+		return
+	}
+	ipKey := throttle.IPKey(srv.clientIP(r))
+	if msg, blocked := srv.throttled(r.Context(), ipKey); blocked {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: msg})
+		return
+	}
+	u, err := srv.Database().FindUserByUsernameOrMail(r.Context(), username)
+	if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+		srv.releaseAttempt(r.Context(), ipKey)
+		slog.ErrorContext(r.Context(), "error while searching user", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err != nil {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Invalid username or password."})
+		return
+	}
+	userKey := throttle.UserKey(u.Subject())
+	if msg, blocked := srv.throttled(r.Context(), userKey); blocked {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: msg})
+		return
+	}
+	err = db.CheckPassword(r.Context(), srv.Database(), u, password)
+	if err != nil && !errors.Is(err, db.ErrInvalidCredentials) {
+		srv.releaseAttempt(r.Context(), ipKey, userKey)
+		slog.ErrorContext(r.Context(), "error while checking password", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err != nil {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Invalid username or password."})
+		return
+	}
+	srv.releaseAttempt(r.Context(), ipKey, userKey)
+	if errorID, msg := checkAccount(u); errorID != "" {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: msg})
+		return
+	}
+	if u.TOTPEnabled() {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Two-factor authentication is already enabled for this account."})
+		return
+	}
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      srv.totpIssuer,
+		AccountName: u.Mail,
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while generating totp key", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	token, err := srv.signToken("totp-enroll", totpEnrollTokenTTL, u.Subject(), key.URL())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while creating totp enroll token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	srv.renderTOTPEnrollKey(w, token, key, "")
+}
+
+// renderTOTPEnrollKey renders the qr code for the given key
+// and asks the user to confirm the enrollment.
+func (srv Server) renderTOTPEnrollKey(w http.ResponseWriter, token string, key *otp.Key, alert string) {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		slog.Error("error while creating totp qr code", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, img); err != nil {
+		slog.Error("error while encoding totp qr code", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	srv.renderTOTPEnrollForm(w, totpEnrollInputs{
+		Token:  token,
+		QRCode: template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())),
+		Secret: key.Secret(),
+		Alert:  alert,
+	})
+}
+
+// handleTOTPEnrollConfirm verifies the code for the newly
+// generated secret and saves the secret for the user.
+func handleTOTPEnrollConfirm(w http.ResponseWriter, r *http.Request, srv Server) {
+	values, err := srv.verifyToken("totp-enroll", r.FormValue("token"))
+	if err != nil || len(values) != 2 {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Your enrollment has expired, please try again."})
+		return
+	}
+	key, err := otp.NewKeyFromURL(values[1])
+	if err != nil {
+		slog.ErrorContext(r.Context(), "error while parsing totp key from enroll token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	ipKey, userKey := throttle.IPKey(srv.clientIP(r)), throttle.UserKey(values[0])
+	if msg, blocked := srv.throttled(r.Context(), ipKey, userKey); blocked {
+		srv.renderTOTPEnrollKey(w, r.FormValue("token"), key, msg)
+		return
+	}
+	u, err := srv.Database().FindUserByID(r.Context(), values[0])
+	if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+		srv.releaseAttempt(r.Context(), ipKey, userKey)
+		slog.ErrorContext(r.Context(), "error while searching user", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err != nil {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Your enrollment has expired, please try again."})
+		return
+	}
+	if errorID, msg := checkAccount(u); errorID != "" {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: msg})
+		return
+	}
+	// an enrollment token must not replace an enrolled device
+	if u.TOTPEnabled() {
+		srv.renderTOTPEnrollForm(w, totpEnrollInputs{Alert: "Two-factor authentication is already enabled for this account."})
+		return
+	}
+	if !totp.Validate(r.FormValue("code"), key.Secret()) {
+		// show the same qr code again
+		srv.renderTOTPEnrollKey(w, r.FormValue("token"), key, "Invalid code.")
+		return
+	}
+	srv.releaseAttempt(r.Context(), ipKey, userKey)
+	if err = srv.Database().UpdateTOTPSecret(r.Context(), u.Subject(), key.Secret()); err != nil {
+		slog.ErrorContext(r.Context(), "error while saving totp secret", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	srv.renderTOTPEnrollForm(w, totpEnrollInputs{Info: "Two-factor authentication has been enabled."})
+}
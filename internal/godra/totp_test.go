@@ -0,0 +1,181 @@
+package godra
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/throttle"
+	"github.com/rbicker/godra/pkg/hydratest"
+)
+
+// newTOTPTestServer creates a login server without throttler
+// and a user with an enrolled totp device.
+func newTOTPTestServer(t *testing.T) (*Server, *hydratest.Server, *db.User) {
+	t.Helper()
+	h := hydratest.NewServer()
+	t.Cleanup(h.Close)
+	d, _ := db.NewMemoryDatabase()
+	u := &db.User{Username: "alice", Roles: []string{}, TOTPSecret: "JBSWY3DPEHPK3PXP"}
+	if err := d.CreateUser(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	srv, err := NewServer(SetDatabase(d), SetHydraClient(newHydraTestClient(t, h)), SetSecret("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, h, u
+}
+
+// postTOTP posts the totp form for the given login challenge.
+func postTOTP(t *testing.T, srv *Server, u *db.User, challenge string, code string) *httptest.ResponseRecorder {
+	t.Helper()
+	token, err := srv.signToken("totp", totpTokenTTL, challenge, u.Subject())
+	if err != nil {
+		t.Fatal(err)
+	}
+	form := url.Values{"challenge": {challenge}, "token": {token}, "code": {code}, "submit": {"login"}}
+	r := httptest.NewRequest(http.MethodPost, "/login/totp", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	srv.GetTOTPHandler()(w, r)
+	return w
+}
+
+func TestTOTPAttemptsAreLimited(t *testing.T) {
+	srv, h, u := newTOTPTestServer(t)
+	challenge := h.AddLoginRequest(hydratest.LoginRequest{Subject: u.Subject()})
+	for i := 1; i < maxTOTPAttempts; i++ {
+		w := postTOTP(t, srv, u, challenge, "abcdef")
+		if !strings.Contains(w.Body.String(), "Invalid code.") {
+			t.Fatalf("attempt %v: expected invalid code, got %v", i, w.Code)
+		}
+	}
+	postTOTP(t, srv, u, challenge, "abcdef")
+	call, ok := h.LastCall("login", "reject")
+	if !ok {
+		t.Fatal("expected the login to be rejected after too many attempts")
+	}
+	var body hydratest.RejectBody
+	if err := call.Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Error != "too_many_attempts" {
+		t.Fatalf("expected error too_many_attempts, got %v", body.Error)
+	}
+	// a valid code does not help after the attempts are used up
+	code, _ := totp.GenerateCode(u.TOTPSecret, time.Now())
+	postTOTP(t, srv, u, challenge, code)
+	if _, ok := h.LastCall("login", "accept"); ok {
+		t.Fatal("expected no accepted login")
+	}
+}
+
+func TestTOTPCannotBeReplayed(t *testing.T) {
+	srv, h, u := newTOTPTestServer(t)
+	code, _ := totp.GenerateCode(u.TOTPSecret, time.Now())
+	first := h.AddLoginRequest(hydratest.LoginRequest{Subject: u.Subject()})
+	if w := postTOTP(t, srv, u, first, code); w.Code != http.StatusTemporaryRedirect {
+		t.Fatalf("expected the login to be accepted, got %v", w.Code)
+	}
+	second := h.AddLoginRequest(hydratest.LoginRequest{Subject: u.Subject()})
+	w := postTOTP(t, srv, u, second, code)
+	if !strings.Contains(w.Body.String(), "Invalid code.") {
+		t.Fatalf("expected the reused code to be refused, got %v", w.Code)
+	}
+	if call, _ := h.LastCall("login", "accept"); call.Challenge != first {
+		t.Fatalf("expected only the first login to be accepted, got %v", call.Challenge)
+	}
+}
+
+func TestTOTPAttemptsAreLimitedInParallel(t *testing.T) {
+	srv, h, u := newTOTPTestServer(t)
+	challenge := h.AddLoginRequest(hydratest.LoginRequest{Subject: u.Subject()})
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		invalid int
+	)
+	for i := 0; i < maxTOTPAttempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := postTOTP(t, srv, u, challenge, "abcdef")
+			if strings.Contains(w.Body.String(), "Invalid code.") {
+				mu.Lock()
+				invalid++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if invalid != maxTOTPAttempts-1 {
+		t.Fatalf("expected %v codes to be checked before the login was rejected, got %v", maxTOTPAttempts-1, invalid)
+	}
+	if _, ok := h.LastCall("login", "reject"); !ok {
+		t.Fatal("expected the login to be rejected after too many attempts")
+	}
+	// the attempts of the rejected login request are removed
+	attempts, err := srv.Database().FindLoginThrottle(context.Background(), throttle.TOTPKey(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts.Failures != 0 {
+		t.Fatalf("expected the attempts to be removed, got %v", attempts.Failures)
+	}
+}
+
+func TestTOTPEnrollCannotReplaceDevice(t *testing.T) {
+	srv, _, u := newTOTPTestServer(t)
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: "godra", AccountName: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := srv.signToken("totp-enroll", totpEnrollTokenTTL, u.Subject(), key.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _ := totp.GenerateCode(key.Secret(), time.Now())
+	form := url.Values{"token": {token}, "code": {code}}
+	r := httptest.NewRequest(http.MethodPost, "/totp", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	srv.GetTOTPEnrollHandler()(w, r)
+	if !strings.Contains(w.Body.String(), "already enabled") {
+		t.Fatalf("expected the enrollment to be refused, got %v", w.Code)
+	}
+	found, err := srv.Database().FindUserByID(context.Background(), u.Subject())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.TOTPSecret != u.TOTPSecret {
+		t.Fatal("expected the enrolled device to be kept")
+	}
+}
+
+func TestEnrollmentIsHiddenForReadOnlyUsers(t *testing.T) {
+	d, _ := db.NewMemoryDatabase()
+	srv, err := NewServer(SetDatabase(readOnlyDatabase{d}), SetWebAuthn("login.example.com", "godra", []string{"https://login.example.com"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlers := map[string]http.HandlerFunc{
+		"/totp":            srv.GetTOTPEnrollHandler(),
+		"/webauthn":        srv.GetWebAuthnEnrollHandler(),
+		"/webauthn/begin":  srv.GetWebAuthnRegisterBeginHandler(),
+		"/webauthn/finish": srv.GetWebAuthnRegisterFinishHandler(),
+	}
+	for path, h := range handlers {
+		w := serve(h, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("expected %v to be not found, got %v", path, w.Code)
+		}
+	}
+}
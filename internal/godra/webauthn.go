@@ -0,0 +1,445 @@
+package godra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/rbicker/godra/internal/db"
+	"github.com/rbicker/godra/internal/logging"
+	"github.com/rbicker/godra/internal/metrics"
+	"github.com/rbicker/godra/internal/throttle"
+)
+
+// duration for which a webauthn ceremony needs to be finished.
+const webauthnTokenTTL = 5 * time.Minute
+
+// duration for which the token, proving that the user's
+// credentials were validated, allows registering passkeys.
+const webauthnEnrollTokenTTL = 10 * time.Minute
+
+// webauthnUser adapts a database user
+// to the webauthn user interface.
+type webauthnUser struct {
+	user *db.User
+}
+
+func (u webauthnUser) WebAuthnID() []byte {
+	return []byte(u.user.Subject())
+}
+
+func (u webauthnUser) WebAuthnName() string {
+	return u.user.Mail
+}
+
+func (u webauthnUser) WebAuthnDisplayName() string {
+	return u.user.Mail
+}
+
+func (u webauthnUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.user.WebAuthnCredentials
+}
+
+// webauthnRequest represents the json body sent by
+// the browser during the webauthn ceremonies.
+type webauthnRequest struct {
+	Challenge  string          `json:"challenge"`
+	Token      string          `json:"token"`
+	Session    string          `json:"session"`
+	Credential json.RawMessage `json:"credential"`
+}
+
+// webauthnResponse represents the json body sent
+// to the browser during the webauthn ceremonies.
+type webauthnResponse struct {
+	Options    interface{} `json:"options,omitempty"`
+	Session    string      `json:"session,omitempty"`
+	RedirectTo string      `json:"redirect_to,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// writeJSON writes the given value as json response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("error while encoding json response", "error", err)
+	}
+}
+
+// decodeWebAuthnRequest decodes the json body of a webauthn
+// request and writes an error response if it is invalid.
+func decodeWebAuthnRequest(w http.ResponseWriter, r *http.Request) (*webauthnRequest, bool) {
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return nil, false
+	}
+	var req webauthnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "invalid request body"})
+		return nil, false
+	}
+	return &req, true
+}
+
+// signSession stores the given webauthn session data in a signed token,
+// which is bound to the given value (login challenge or user id).
+func (srv Server) signSession(purpose string, value string, session *webauthn.SessionData) (string, error) {
+	b, err := json.Marshal(session)
+	if err != nil {
+		return "", err
+	}
+	return srv.signToken(purpose, webauthnTokenTTL, value, string(b))
+}
+
+// verifySession verifies the signed token and returns the
+// contained webauthn session data, if it is bound to the given value.
+func (srv Server) verifySession(purpose string, value string, token string) (*webauthn.SessionData, bool) {
+	values, err := srv.verifyToken(purpose, token)
+	if err != nil || len(values) != 2 || values[0] != value {
+		return nil, false
+	}
+	var session webauthn.SessionData
+	if err = json.Unmarshal([]byte(values[1]), &session); err != nil {
+		return nil, false
+	}
+	return &session, true
+}
+
+// GetWebAuthnLoginBeginHandler returns the handler for the
+// /login/webauthn/begin route.
+// It expects the login challenge and responds with the
+// options for navigator.credentials.get() as well as a
+// signed session which needs to be sent back on finish.
+func (srv Server) GetWebAuthnLoginBeginHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, ok := decodeWebAuthnRequest(w, r)
+		if !ok {
+			return
+		}
+		if req.Challenge == "" {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "login challenge not set"})
+			return
+		}
+		options, session, err := srv.webauthn.BeginDiscoverableLogin(
+			webauthn.WithUserVerification(protocol.VerificationRequired),
+		)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while beginning webauthn login", "error", err)
+			writeJSON(w, http.StatusInternalServerError, webauthnResponse{Error: "unable to begin passkey login"})
+			return
+		}
+		token, err := srv.signSession("webauthn-login", req.Challenge, session)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while creating webauthn login token", "error", err)
+			writeJSON(w, http.StatusInternalServerError, webauthnResponse{Error: "unable to begin passkey login"})
+			return
+		}
+		writeJSON(w, http.StatusOK, webauthnResponse{Options: options, Session: token})
+	}
+}
+
+// GetWebAuthnLoginFinishHandler returns the handler for the
+// /login/webauthn/finish route.
+// It validates the assertion created by the authenticator,
+// accepts the login request and responds with the url
+// the browser needs to be redirected to.
+func (srv Server) GetWebAuthnLoginFinishHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, ok := decodeWebAuthnRequest(w, r)
+		if !ok {
+			return
+		}
+		r = r.WithContext(logging.With(r.Context(), "login_challenge", req.Challenge))
+		session, ok := srv.verifySession("webauthn-login", req.Challenge, req.Session)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Your login has expired, please try again."})
+			return
+		}
+		parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(req.Credential))
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Invalid passkey response."})
+			return
+		}
+		var u *db.User
+		handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+			found, err := srv.Database().FindUserByID(r.Context(), string(userHandle))
+			if err != nil {
+				return nil, err
+			}
+			u = found
+			return webauthnUser{user: found}, nil
+		}
+		credential, err := srv.webauthn.ValidateDiscoverableLogin(handler, *session, parsed)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "webauthn login failed", "error", err)
+			writeJSON(w, http.StatusUnauthorized, webauthnResponse{Error: "Passkey not recognized."})
+			return
+		}
+		r = r.WithContext(logging.With(r.Context(), "subject", u.Subject()))
+		if credential.Authenticator.CloneWarning {
+			slog.WarnContext(r.Context(), "webauthn sign count indicates a cloned authenticator")
+			writeJSON(w, http.StatusUnauthorized, webauthnResponse{Error: "Passkey not recognized."})
+			return
+		}
+		errorID, msg, err := srv.checkLogin(r.Context(), req.Challenge, u)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while checking login", "error", err)
+			status, alert := hydraErrorMessage(err)
+			writeJSON(w, status, webauthnResponse{Error: alert})
+			return
+		}
+		if errorID != "" {
+			// reject the login and let the browser offer
+			// to return to the application
+			slog.InfoContext(r.Context(), "rejecting login request", "error_id", errorID)
+			body, err := srv.hydraclient.RejectLoginRequestContext(r.Context(), req.Challenge, errorID, msg)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error while rejecting login request", "error", err)
+				status, alert := hydraErrorMessage(err)
+				writeJSON(w, status, webauthnResponse{Error: alert})
+				return
+			}
+			writeJSON(w, http.StatusForbidden, webauthnResponse{Error: msg, RedirectTo: body.GetRedirectTo()})
+			return
+		}
+		// store the updated sign count
+		for i, c := range u.WebAuthnCredentials {
+			if bytes.Equal(c.ID, credential.ID) {
+				u.WebAuthnCredentials[i] = *credential
+			}
+		}
+		if err = srv.Database().UpdateWebAuthnCredentials(r.Context(), u.Subject(), u.WebAuthnCredentials); err != nil {
+			slog.ErrorContext(r.Context(), "error while updating webauthn credentials", "error", err)
+		}
+		amr := []string{"hwk"}
+		if credential.Flags.UserVerified {
+			amr = append(amr, "user")
+		}
+		slog.InfoContext(r.Context(), "accepting login request", "amr", amr)
+		body, err := srv.hydraclient.AcceptLoginRequestContext(r.Context(), req.Challenge, true, 7200, u.Subject(), "phr", amr)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while accepting login request", "error", err)
+			status, alert := hydraErrorMessage(err)
+			writeJSON(w, status, webauthnResponse{Error: alert})
+			return
+		}
+		metrics.LoginAttempt(metrics.LoginSuccess)
+		writeJSON(w, http.StatusOK, webauthnResponse{RedirectTo: body.GetRedirectTo()})
+	}
+}
+
+// inputs for the webauthn enrollment page.
+type webauthnEnrollInputs struct {
+	Token      string
+	Alert      string
+	Stylesheet template.HTML
+}
+
+// renderWebAuthnEnrollForm renders the passkey enrollment page.
+// If a token is given, the page allows registering a passkey.
+func (srv Server) renderWebAuthnEnrollForm(w http.ResponseWriter, inputs webauthnEnrollInputs) {
+	inputs.Stylesheet = srv.stylesheet()
+	srv.renderTemplate(w, "webauthn-enroll", inputs)
+}
+
+// GetWebAuthnEnrollHandler returns the handler for the /webauthn route.
+// The GET request shows a form asking for the user's credentials.
+// The POST request validates the credentials (including the
+// totp code if enabled) and shows the page which registers
+// a new passkey using the /webauthn/begin and /webauthn/finish routes.
+func (srv Server) GetWebAuthnEnrollHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !srv.enrollmentEnabled() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "GET":
+			srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{})
+		case "POST":
+			err := r.ParseForm()
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error parsing form in webauthn enroll post request", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			username, password, code := r.FormValue("username"), r.FormValue("password"), r.FormValue("code")
+			if username == "" || password == "" {
+				srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: "Username or Password not set."})
+				return
+			}
+			ipKey := throttle.IPKey(srv.clientIP(r))
+			if msg, blocked := srv.throttled(r.Context(), ipKey); blocked {
+				srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: msg})
+				return
+			}
+			u, err := srv.Database().FindUserByUsernameOrMail(r.Context(), username)
+			if err != nil && !errors.Is(err, db.ErrUserNotFound) {
+				srv.releaseAttempt(r.Context(), ipKey)
+				slog.ErrorContext(r.Context(), "error while searching user", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if err != nil {
+				srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: "Invalid username or password."})
+				return
+			}
+			userKey := throttle.UserKey(u.Subject())
+			if msg, blocked := srv.throttled(r.Context(), userKey); blocked {
+				srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: msg})
+				return
+			}
+			err = db.CheckPassword(r.Context(), srv.Database(), u, password)
+			if err != nil && !errors.Is(err, db.ErrInvalidCredentials) {
+				srv.releaseAttempt(r.Context(), ipKey, userKey)
+				slog.ErrorContext(r.Context(), "error while checking password", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if err != nil {
+				srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: "Invalid username or password."})
+				return
+			}
+			if errorID, msg := checkAccount(u); errorID != "" {
+				srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: msg})
+				return
+			}
+			if u.TOTPEnabled() {
+				if err = srv.checkTOTP(r.Context(), u, code); err != nil {
+					if !errors.Is(err, errInvalidTOTP) {
+						srv.releaseAttempt(r.Context(), ipKey, userKey)
+						slog.ErrorContext(r.Context(), "error while checking one-time password", "error", err)
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Alert: "Invalid code."})
+					return
+				}
+			}
+			srv.releaseAttempt(r.Context(), ipKey, userKey)
+			token, err := srv.signToken("webauthn-enroll", webauthnEnrollTokenTTL, u.Subject())
+			if err != nil {
+				slog.ErrorContext(r.Context(), "error while creating webauthn enroll token", "error", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			srv.renderWebAuthnEnrollForm(w, webauthnEnrollInputs{Token: token})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// enrollingUser returns the user for which the given enroll token was issued.
+func (srv Server) enrollingUser(ctx context.Context, token string) (*db.User, bool) {
+	values, err := srv.verifyToken("webauthn-enroll", token)
+	if err != nil || len(values) != 1 {
+		return nil, false
+	}
+	u, err := srv.Database().FindUserByID(ctx, values[0])
+	if err != nil {
+		return nil, false
+	}
+	return u, true
+}
+
+// GetWebAuthnRegisterBeginHandler returns the handler for the
+// /webauthn/begin route.
+// It expects the enroll token and responds with the
+// options for navigator.credentials.create() as well as a
+// signed session which needs to be sent back on finish.
+func (srv Server) GetWebAuthnRegisterBeginHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !srv.enrollmentEnabled() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		req, ok := decodeWebAuthnRequest(w, r)
+		if !ok {
+			return
+		}
+		u, ok := srv.enrollingUser(r.Context(), req.Token)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Your enrollment has expired, please try again."})
+			return
+		}
+		var exclusions []protocol.CredentialDescriptor
+		for _, c := range u.WebAuthnCredentials {
+			exclusions = append(exclusions, c.Descriptor())
+		}
+		options, session, err := srv.webauthn.BeginRegistration(
+			webauthnUser{user: u},
+			webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+			webauthn.WithExclusions(exclusions),
+		)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while beginning webauthn registration", "error", err)
+			writeJSON(w, http.StatusInternalServerError, webauthnResponse{Error: "unable to begin passkey registration"})
+			return
+		}
+		token, err := srv.signSession("webauthn-register", u.Subject(), session)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error while creating webauthn registration token", "error", err)
+			writeJSON(w, http.StatusInternalServerError, webauthnResponse{Error: "unable to begin passkey registration"})
+			return
+		}
+		writeJSON(w, http.StatusOK, webauthnResponse{Options: options, Session: token})
+	}
+}
+
+// GetWebAuthnRegisterFinishHandler returns the handler for the
+// /webauthn/finish route.
+// It validates the attestation created by the authenticator
+// and stores the new credential for the user.
+func (srv Server) GetWebAuthnRegisterFinishHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !srv.enrollmentEnabled() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		req, ok := decodeWebAuthnRequest(w, r)
+		if !ok {
+			return
+		}
+		u, ok := srv.enrollingUser(r.Context(), req.Token)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Your enrollment has expired, please try again."})
+			return
+		}
+		session, ok := srv.verifySession("webauthn-register", u.Subject(), req.Session)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Your enrollment has expired, please try again."})
+			return
+		}
+		parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(req.Credential))
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Invalid passkey response."})
+			return
+		}
+		credential, err := srv.webauthn.CreateCredential(webauthnUser{user: u}, *session, parsed)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "webauthn registration failed", "error", err)
+			writeJSON(w, http.StatusBadRequest, webauthnResponse{Error: "Passkey registration failed."})
+			return
+		}
+		credentials := append(u.WebAuthnCredentials, *credential)
+		if err = srv.Database().UpdateWebAuthnCredentials(r.Context(), u.Subject(), credentials); err != nil {
+			slog.ErrorContext(r.Context(), "error while saving webauthn credential", "error", err)
+			writeJSON(w, http.StatusInternalServerError, webauthnResponse{Error: "unable to save passkey"})
+			return
+		}
+		writeJSON(w, http.StatusOK, webauthnResponse{})
+	}
+}
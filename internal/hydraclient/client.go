@@ -1,38 +1,153 @@
 package hydraclient
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rbicker/godra/internal/logging"
 )
 
 // Client represents a hyra client.
+// Copies of the client share its connection pool and circuit breaker.
 type Client struct {
 	hydraPrivateURL string
+	requestID       string
+	httpClient      *http.Client
+	forwardedProto  string
+	authorization   string
+	timeout         time.Duration
+	idleConnTimeout time.Duration
+	maxIdleConns    int
+	tlsConfig       *tls.Config
+	retries         int
+	retryDelay      time.Duration
+	breaker         *breaker
 }
 
 // ensure Client implements the HydraClient interface.
 var _ HydraClient = Client{}
 
+// defaultHTTPClient is used by clients which were not created using New.
+var defaultHTTPClient = &http.Client{Timeout: 5 * time.Second}
+
+// New creates a client for hydra's admin api at the given url.
+// It takes functional parameters to change default options such
+// as the timeout. The client keeps a pool of connections to hydra.
+// By default, the X-Forwarded-Proto header is set to https, so
+// hydra accepts the requests on its admin port without tls.
+// Failed requests are retried twice and after 5 calls in a row
+// have failed, requests fail fast for 30 seconds.
+func New(hydraPrivateURL string, opts ...func(*Client) error) (Client, error) {
+	c := Client{
+		hydraPrivateURL: strings.TrimSuffix(hydraPrivateURL, "/"),
+		forwardedProto:  "https",
+		timeout:         5 * time.Second,
+		idleConnTimeout: 90 * time.Second,
+		maxIdleConns:    10,
+		retries:         2,
+		retryDelay:      100 * time.Millisecond,
+		breaker:         &breaker{threshold: 5, cooldown: 30 * time.Second},
+	}
+	for _, op := range opts {
+		if err := op(&c); err != nil {
+			return Client{}, fmt.Errorf("setting hydra client option failed: %w", err)
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = c.maxIdleConns
+	transport.MaxIdleConnsPerHost = c.maxIdleConns
+	transport.IdleConnTimeout = c.idleConnTimeout
+	if c.tlsConfig != nil {
+		transport.TLSClientConfig = c.tlsConfig
+	}
+	c.httpClient = &http.Client{Transport: transport, Timeout: c.timeout}
+	return c, nil
+}
+
 // SetHydraPrivateURL sets the hydra server's private URL.
 func (c *Client) SetHydraPrivateURL(url string) {
 	c.hydraPrivateURL = url
 }
 
+// WithRequestID returns a copy of the client, which sends the
+// given request id to hydra in the X-Request-ID header,
+// so the requests can be correlated in the logs.
+// Otherwise, the request id stored in the context is sent.
+func (c Client) WithRequestID(id string) Client {
+	c.requestID = id
+	return c
+}
+
+// do sets the headers sent with all requests to hydra and sends the request.
+func (c Client) do(req *http.Request) (*http.Response, error) {
+	if c.forwardedProto != "" {
+		req.Header.Set("X-Forwarded-Proto", c.forwardedProto)
+	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
+	requestID := c.requestID
+	if requestID == "" {
+		requestID = logging.RequestID(req.Context())
+	}
+	if requestID != "" {
+		req.Header.Set(logging.RequestIDHeader, requestID)
+	}
+	client := c.httpClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	return client.Do(req)
+}
+
 type getLoginRequestResponse struct {
-	Skip    bool   `json:"skip"`
-	Subject string `json:"subject"`
+	Challenge                    string       `json:"challenge"`
+	Skip                         bool         `json:"skip"`
+	Subject                      string       `json:"subject"`
+	Client                       OAuth2Client `json:"client"`
+	RequestURL                   string       `json:"request_url"`
+	RequestedScope               []string     `json:"requested_scope"`
+	RequestedAccessTokenAudience []string     `json:"requested_access_token_audience"`
+	SessionID                    string       `json:"session_id"`
+	OIDCContext                  OIDCContext  `json:"oidc_context"`
 }
 
+func (r getLoginRequestResponse) GetChallenge() string {
+	return r.Challenge
+}
 func (r getLoginRequestResponse) GetSkip() bool {
 	return r.Skip
 }
 func (r getLoginRequestResponse) GetSubject() string {
 	return r.Subject
 }
+func (r getLoginRequestResponse) GetClient() OAuth2Client {
+	return r.Client
+}
+func (r getLoginRequestResponse) GetRequestURL() string {
+	return r.RequestURL
+}
+func (r getLoginRequestResponse) GetRequestedScope() []string {
+	return r.RequestedScope
+}
+func (r getLoginRequestResponse) GetRequestedAccessTokenAudience() []string {
+	return r.RequestedAccessTokenAudience
+}
+func (r getLoginRequestResponse) GetSessionID() string {
+	return r.SessionID
+}
+func (r getLoginRequestResponse) GetOIDCContext() OIDCContext {
+	return r.OIDCContext
+}
 
-// GetLoginRequest queries the login request from hydra.
-func (c Client) GetLoginRequest(challenge string) (GetLoginRequestResponse, error) {
-	res, err := c.Get("login", challenge)
+// GetLoginRequestContext queries the login request from hydra.
+func (c Client) GetLoginRequestContext(ctx context.Context, challenge string) (GetLoginRequestResponse, error) {
+	res, err := c.GetContext(ctx, "login", challenge)
 	if err != nil {
 		return nil, fmt.Errorf("receiving login request failed: %w", err)
 	}
@@ -44,6 +159,13 @@ func (c Client) GetLoginRequest(challenge string) (GetLoginRequestResponse, erro
 	return resBody, nil
 }
 
+// GetLoginRequest is like GetLoginRequestContext using the background context.
+//
+// Deprecated: use GetLoginRequestContext, so the request can be cancelled.
+func (c Client) GetLoginRequest(challenge string) (GetLoginRequestResponse, error) {
+	return c.GetLoginRequestContext(context.Background(), challenge)
+}
+
 type acceptLoginRequestResponse struct {
 	RedirectTo string `json:"redirect_to"`
 }
@@ -52,25 +174,33 @@ func (r acceptLoginRequestResponse) GetRedirectTo() string {
 	return r.RedirectTo
 }
 
-// AcceptLoginRequest accepts the login request by
+// AcceptLoginRequestContext accepts the login request by
 // responding to the hydra server.
-func (c Client) AcceptLoginRequest(challenge string, remember bool, rememberFor int, subject string) (AcceptLoginRequestResponse, error) {
+// The authentication context class reference (acr) and the
+// authentication methods references (amr) are optional and
+// will be included in the id token.
+func (c Client) AcceptLoginRequestContext(ctx context.Context, challenge string, remember bool, rememberFor int, subject string, acr string, amr []string) (AcceptLoginRequestResponse, error) {
 	reqBody, err := json.Marshal(struct {
-		Remember    bool   `json:"remember"`
-		RememberFor int    `json:"remember_for"`
-		Subject     string `json:"subject"`
+		Remember    bool     `json:"remember"`
+		RememberFor int      `json:"remember_for"`
+		Subject     string   `json:"subject"`
+		ACR         string   `json:"acr,omitempty"`
+		AMR         []string `json:"amr,omitempty"`
 	}{
 		Remember:    remember,
 		RememberFor: rememberFor,
 		Subject:     subject,
+		ACR:         acr,
+		AMR:         amr,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("cloud not create request body: %w", err)
 	}
-	res, err := c.Put("login", "accept", challenge, reqBody)
+	res, err := c.PutContext(ctx, "login", "accept", challenge, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("accepting login request failed: %w", err)
 	}
+	defer res.Body.Close()
 	var resBody acceptLoginRequestResponse
 	if err = json.NewDecoder(res.Body).Decode(&resBody); err != nil {
 		return nil, fmt.Errorf("could not decode response body: %w", err)
@@ -78,6 +208,13 @@ func (c Client) AcceptLoginRequest(challenge string, remember bool, rememberFor
 	return resBody, nil
 }
 
+// AcceptLoginRequest is like AcceptLoginRequestContext using the background context.
+//
+// Deprecated: use AcceptLoginRequestContext, so the request can be cancelled.
+func (c Client) AcceptLoginRequest(challenge string, remember bool, rememberFor int, subject string, acr string, amr []string) (AcceptLoginRequestResponse, error) {
+	return c.AcceptLoginRequestContext(context.Background(), challenge, remember, rememberFor, subject, acr, amr)
+}
+
 type rejectLoginRequestResponse struct {
 	RedirectTo string `json:"redirect_to"`
 }
@@ -86,9 +223,9 @@ func (r rejectLoginRequestResponse) GetRedirectTo() string {
 	return r.RedirectTo
 }
 
-// RejectLoginRequest rejects the login request
+// RejectLoginRequestContext rejects the login request
 // by responding to the hydra server.
-func (c Client) RejectLoginRequest(challenge string, errorID string, errorDescription string) (RejectLoginRequestResponse, error) {
+func (c Client) RejectLoginRequestContext(ctx context.Context, challenge string, errorID string, errorDescription string) (RejectLoginRequestResponse, error) {
 	reqBody, err := json.Marshal(map[string]string{
 		"error":             errorID,
 		"error_description": errorDescription,
@@ -96,7 +233,7 @@ func (c Client) RejectLoginRequest(challenge string, errorID string, errorDescri
 	if err != nil {
 		return nil, fmt.Errorf("could not create request body: %w", err)
 	}
-	res, err := c.Put("login", "reject", challenge, reqBody)
+	res, err := c.PutContext(ctx, "login", "reject", challenge, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("rejecting login request failed: %w", err)
 	}
@@ -108,16 +245,45 @@ func (c Client) RejectLoginRequest(challenge string, errorID string, errorDescri
 	return resBody, nil
 }
 
+// RejectLoginRequest is like RejectLoginRequestContext using the background context.
+//
+// Deprecated: use RejectLoginRequestContext, so the request can be cancelled.
+func (c Client) RejectLoginRequest(challenge string, errorID string, errorDescription string) (RejectLoginRequestResponse, error) {
+	return c.RejectLoginRequestContext(context.Background(), challenge, errorID, errorDescription)
+}
+
 type getConsentRequestResponse struct {
-	Subject                      string   `json:"subject"`
-	RequestedScope               []string `json:"requested_scope"`
-	RequestedAccessTokenAudience []string `json:"requested_access_token_audience"`
+	Challenge                    string                 `json:"challenge"`
+	Skip                         bool                   `json:"skip"`
+	Subject                      string                 `json:"subject"`
+	Client                       OAuth2Client           `json:"client"`
+	RequestURL                   string                 `json:"request_url"`
+	RequestedScope               []string               `json:"requested_scope"`
+	RequestedAccessTokenAudience []string               `json:"requested_access_token_audience"`
+	LoginChallenge               string                 `json:"login_challenge"`
+	LoginSessionID               string                 `json:"login_session_id"`
+	ACR                          string                 `json:"acr"`
+	AMR                          []string               `json:"amr"`
+	OIDCContext                  OIDCContext            `json:"oidc_context"`
+	Context                      map[string]interface{} `json:"context"`
+}
+
+func (r getConsentRequestResponse) GetChallenge() string {
+	return r.Challenge
+}
+
+func (r getConsentRequestResponse) GetSkip() bool {
+	return r.Skip
 }
 
 func (r getConsentRequestResponse) GetSubject() string {
 	return r.Subject
 }
 
+func (r getConsentRequestResponse) GetClient() OAuth2Client {
+	return r.Client
+}
+
 func (r getConsentRequestResponse) GetRequestedScope() []string {
 	return r.RequestedScope
 }
@@ -126,12 +292,40 @@ func (r getConsentRequestResponse) GetRequestedAccessTokenAudience() []string {
 	return r.RequestedAccessTokenAudience
 }
 
-// GetConsentRequest queries additional
+func (r getConsentRequestResponse) GetRequestURL() string {
+	return r.RequestURL
+}
+
+func (r getConsentRequestResponse) GetLoginChallenge() string {
+	return r.LoginChallenge
+}
+
+func (r getConsentRequestResponse) GetLoginSessionID() string {
+	return r.LoginSessionID
+}
+
+func (r getConsentRequestResponse) GetACR() string {
+	return r.ACR
+}
+
+func (r getConsentRequestResponse) GetAMR() []string {
+	return r.AMR
+}
+
+func (r getConsentRequestResponse) GetOIDCContext() OIDCContext {
+	return r.OIDCContext
+}
+
+func (r getConsentRequestResponse) GetContext() map[string]interface{} {
+	return r.Context
+}
+
+// GetConsentRequestContext queries additional
 // information from the hydra server.
-func (c Client) GetConsentRequest(challenge string) (GetConsentRequestResponse, error) {
+func (c Client) GetConsentRequestContext(ctx context.Context, challenge string) (GetConsentRequestResponse, error) {
 	// query consent request information from hydra
 	// using given login challenge
-	res, err := c.Get("consent", challenge)
+	res, err := c.GetContext(ctx, "consent", challenge)
 	if err != nil {
 		return nil, fmt.Errorf("receiving consent request failed: %w", err)
 	}
@@ -143,6 +337,13 @@ func (c Client) GetConsentRequest(challenge string) (GetConsentRequestResponse,
 	return resBody, nil
 }
 
+// GetConsentRequest is like GetConsentRequestContext using the background context.
+//
+// Deprecated: use GetConsentRequestContext, so the request can be cancelled.
+func (c Client) GetConsentRequest(challenge string) (GetConsentRequestResponse, error) {
+	return c.GetConsentRequestContext(context.Background(), challenge)
+}
+
 type acceptConsentRequestResponse struct {
 	RedirectTo string `json:"redirect_to"`
 }
@@ -151,27 +352,39 @@ func (r acceptConsentRequestResponse) GetRedirectTo() string {
 	return r.RedirectTo
 }
 
-// AcceptConsentRequest accepts the consent request
+// ConsentSession contains the claims which hydra
+// adds to the id token and the access token.
+type ConsentSession struct {
+	IDToken     map[string]interface{} `json:"id_token,omitempty"`
+	AccessToken map[string]interface{} `json:"access_token,omitempty"`
+}
+
+// AcceptConsentRequestContext accepts the consent request
 // by responding to the hydra server.
-func (c Client) AcceptConsentRequest(challenge string, remember bool, rememberFor int, grantScope []string, grantAccessTokenAudience []string) (AcceptConsentRequestResponse, error) {
+// The claims of the session are optional and will
+// be included in the issued tokens.
+func (c Client) AcceptConsentRequestContext(ctx context.Context, challenge string, remember bool, rememberFor int, grantScope []string, grantAccessTokenAudience []string, session ConsentSession) (AcceptConsentRequestResponse, error) {
 	reqBody, err := json.Marshal(struct {
-		Remember                 bool     `json:"remember"`
-		RememberFor              int      `json:"remember_for"`
-		GrantScope               []string `json:"grant_scope"`
-		GrantAccessTokenAudience []string `json:"grant_access_token_audience"`
+		Remember                 bool           `json:"remember"`
+		RememberFor              int            `json:"remember_for"`
+		GrantScope               []string       `json:"grant_scope"`
+		GrantAccessTokenAudience []string       `json:"grant_access_token_audience"`
+		Session                  ConsentSession `json:"session"`
 	}{
 		Remember:                 remember,
 		RememberFor:              rememberFor,
 		GrantScope:               grantScope,
 		GrantAccessTokenAudience: grantAccessTokenAudience,
+		Session:                  session,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("cloud not create request body: %w", err)
 	}
-	res, err := c.Put("consent", "accept", challenge, reqBody)
+	res, err := c.PutContext(ctx, "consent", "accept", challenge, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("accepting consent request failed: %w", err)
 	}
+	defer res.Body.Close()
 	var resBody acceptConsentRequestResponse
 	if err = json.NewDecoder(res.Body).Decode(&resBody); err != nil {
 		return nil, fmt.Errorf("could not decode response body: %w", err)
@@ -179,19 +392,88 @@ func (c Client) AcceptConsentRequest(challenge string, remember bool, rememberFo
 	return resBody, nil
 }
 
+// AcceptConsentRequest is like AcceptConsentRequestContext using the background context.
+//
+// Deprecated: use AcceptConsentRequestContext, so the request can be cancelled.
+func (c Client) AcceptConsentRequest(challenge string, remember bool, rememberFor int, grantScope []string, grantAccessTokenAudience []string, session ConsentSession) (AcceptConsentRequestResponse, error) {
+	return c.AcceptConsentRequestContext(context.Background(), challenge, remember, rememberFor, grantScope, grantAccessTokenAudience, session)
+}
+
+type rejectConsentRequestResponse struct {
+	RedirectTo string `json:"redirect_to"`
+}
+
+func (r rejectConsentRequestResponse) GetRedirectTo() string {
+	return r.RedirectTo
+}
+
+// RejectConsentRequestContext rejects the consent request
+// by responding to the hydra server.
+func (c Client) RejectConsentRequestContext(ctx context.Context, challenge string, errorID string, errorDescription string) (RejectConsentRequestResponse, error) {
+	reqBody, err := json.Marshal(map[string]string{
+		"error":             errorID,
+		"error_description": errorDescription,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create request body: %w", err)
+	}
+	res, err := c.PutContext(ctx, "consent", "reject", challenge, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("rejecting consent request failed: %w", err)
+	}
+	defer res.Body.Close()
+	var resBody rejectConsentRequestResponse
+	if err = json.NewDecoder(res.Body).Decode(&resBody); err != nil {
+		return nil, fmt.Errorf("could not decode response body: %w", err)
+	}
+	return resBody, nil
+}
+
+// RejectConsentRequest is like RejectConsentRequestContext using the background context.
+//
+// Deprecated: use RejectConsentRequestContext, so the request can be cancelled.
+func (c Client) RejectConsentRequest(challenge string, errorID string, errorDescription string) (RejectConsentRequestResponse, error) {
+	return c.RejectConsentRequestContext(context.Background(), challenge, errorID, errorDescription)
+}
+
 type getLogoutRequestResponse struct {
-	Subject string `json:"subject"`
+	Challenge   string        `json:"challenge"`
+	Subject     string        `json:"subject"`
+	SessionID   string        `json:"sid"`
+	RequestURL  string        `json:"request_url"`
+	RPInitiated bool          `json:"rp_initiated"`
+	Client      *OAuth2Client `json:"client"`
+}
+
+func (r getLogoutRequestResponse) GetChallenge() string {
+	return r.Challenge
 }
 
 func (r getLogoutRequestResponse) GetSubject() string {
 	return r.Subject
 }
 
-// GetLogoutRequest queries the logout request from hydra.
-func (c Client) GetLogoutRequest(challenge string) (GetLogoutRequestResponse, error) {
+func (r getLogoutRequestResponse) GetSessionID() string {
+	return r.SessionID
+}
+
+func (r getLogoutRequestResponse) GetRequestURL() string {
+	return r.RequestURL
+}
+
+func (r getLogoutRequestResponse) GetRPInitiated() bool {
+	return r.RPInitiated
+}
+
+func (r getLogoutRequestResponse) GetClient() *OAuth2Client {
+	return r.Client
+}
+
+// GetLogoutRequestContext queries the logout request from hydra.
+func (c Client) GetLogoutRequestContext(ctx context.Context, challenge string) (GetLogoutRequestResponse, error) {
 	// query logout request information from hydra
 	// using given login challenge
-	res, err := c.Get("logout", challenge)
+	res, err := c.GetContext(ctx, "logout", challenge)
 	if err != nil {
 		return nil, fmt.Errorf("receiving logout request failed: %w", err)
 	}
@@ -203,6 +485,13 @@ func (c Client) GetLogoutRequest(challenge string) (GetLogoutRequestResponse, er
 	return resBody, nil
 }
 
+// GetLogoutRequest is like GetLogoutRequestContext using the background context.
+//
+// Deprecated: use GetLogoutRequestContext, so the request can be cancelled.
+func (c Client) GetLogoutRequest(challenge string) (GetLogoutRequestResponse, error) {
+	return c.GetLogoutRequestContext(context.Background(), challenge)
+}
+
 type acceptLogoutRequestResponse struct {
 	RedirectTo string `json:"redirect_to"`
 }
@@ -211,16 +500,42 @@ func (r acceptLogoutRequestResponse) GetRedirectTo() string {
 	return r.RedirectTo
 }
 
-// AcceptLogoutRequest accepts the logout request
+// AcceptLogoutRequestContext accepts the logout request
 // by responding to the hydra server.
-func (c Client) AcceptLogoutRequest(challenge string) (AcceptLogoutRequestResponse, error) {
-	res, err := c.Put("logout", "accept", challenge, nil)
+func (c Client) AcceptLogoutRequestContext(ctx context.Context, challenge string) (AcceptLogoutRequestResponse, error) {
+	res, err := c.PutContext(ctx, "logout", "accept", challenge, nil)
 	if err != nil {
 		return nil, fmt.Errorf("accepting logout request failed: %w", err)
 	}
+	defer res.Body.Close()
 	var resBody acceptConsentRequestResponse
 	if err = json.NewDecoder(res.Body).Decode(&resBody); err != nil {
 		return nil, fmt.Errorf("could not decode response body: %w", err)
 	}
 	return resBody, nil
 }
+
+// AcceptLogoutRequest is like AcceptLogoutRequestContext using the background context.
+//
+// Deprecated: use AcceptLogoutRequestContext, so the request can be cancelled.
+func (c Client) AcceptLogoutRequest(challenge string) (AcceptLogoutRequestResponse, error) {
+	return c.AcceptLogoutRequestContext(context.Background(), challenge)
+}
+
+// Ready checks if hydra is ready to handle requests
+// using its readiness endpoint.
+func (c Client) Ready(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/health/ready", c.hydraPrivateURL), nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	res, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("http request failed: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("hydra is not ready: %s", res.Status)
+	}
+	return nil
+}
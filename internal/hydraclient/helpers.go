@@ -2,38 +2,38 @@ package hydraclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
-)
 
-// error response for all kind of flows.
-type errorResponse struct {
-	Error            string `json:"error"`
-	ErrorDescription string `json:"error_description"`
-	ErrorDebug       string `json:"error_debug"`
-	StatusCode       int    `json:"status_code"`
-}
+	"github.com/rbicker/godra/internal/metrics"
+)
 
-// determineError extracts the error message from the
+// determineError extracts the error from the
 // http response if status code is unexpected.
 func determineError(res *http.Response) error {
 	if res.StatusCode < 200 || res.StatusCode > 302 {
-		var resBody errorResponse
-		err := json.NewDecoder(res.Body).Decode(&resBody)
-		if err != nil {
-			return err
+		var e Error
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
+			// not an answer of hydra, such as from a proxy in front of it
+			return &Error{Name: strings.ToLower(http.StatusText(res.StatusCode)), StatusCode: res.StatusCode}
 		}
-		return fmt.Errorf("%v; %s; %s; %s", resBody.StatusCode, resBody.Error, resBody.ErrorDescription, resBody.ErrorDebug)
+		if e.StatusCode == 0 {
+			e.StatusCode = res.StatusCode
+		}
+		return &e
 	}
 	return nil
 }
 
-// Get queries the given challenge from hydra.
+// GetContext queries the given challenge from hydra.
 // The flow can be "login", "consent" or "logout".
-func (c *Client) Get(flow, challenge string) (*http.Response, error) {
+// Transient failures are retried.
+func (c *Client) GetContext(ctx context.Context, flow, challenge string) (*http.Response, error) {
 	if flow != "login" && flow != "consent" && flow != "logout" {
 		return nil, fmt.Errorf("invalid flow: %s", flow)
 	}
@@ -42,57 +42,50 @@ func (c *Client) Get(flow, challenge string) (*http.Response, error) {
 	}
 	params := url.Values{}
 	params.Add(fmt.Sprintf("%s_challenge", flow), challenge)
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/oauth2/auth/requests/%s?%s", c.hydraPrivateURL, flow, params.Encode()), nil)
-	if err != nil {
-		return nil, fmt.Errorf("could not create request: %w", err)
-	}
-	req.Header.Set("X-Forwarded-Proto", "https")
-	client := http.Client{
-		Timeout: time.Second * 5,
-	}
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http request failed: %w", err)
-	}
-	if err = determineError(res); err != nil {
-		return nil, fmt.Errorf("unexpected response: %w", err)
-	}
-	return res, nil
+	defer metrics.ObserveHydraRequest(flow, "get", time.Now())
+	return c.send(ctx, func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/oauth2/auth/requests/%s?%s", c.hydraPrivateURL, flow, params.Encode()), nil)
+	})
 }
 
-// Put sends an "accept" or "deny" request with the given body
+// PutContext sends an "accept" or "reject" request with the given body
 // to hydra, depending on the given action.
 // The flow can be "login", "consent" or "logout".
-func (c *Client) Put(flow, action, challenge string, body []byte) (*http.Response, error) {
+// Transient failures are retried, hydra handles repeated
+// accept and reject requests of a challenge the same way.
+func (c *Client) PutContext(ctx context.Context, flow, action, challenge string, body []byte) (*http.Response, error) {
 	if flow != "login" && flow != "consent" && flow != "logout" {
 		return nil, fmt.Errorf("invalid flow: %s", flow)
 	}
 	if challenge == "" {
 		return nil, fmt.Errorf("empty challenge given for flow %s", flow)
 	}
-	if action != "accept" && action != "deny" {
+	if action != "accept" && action != "reject" {
 		return nil, fmt.Errorf("invalid action: %s", action)
 	}
 	params := url.Values{}
 	params.Add(fmt.Sprintf("%s_challenge", flow), challenge)
-	req, err := http.NewRequest(
-		http.MethodPut,
-		fmt.Sprintf("%s/oauth2/auth/requests/%s/%s?%s", c.hydraPrivateURL, flow, action, params.Encode()),
-		bytes.NewBuffer(body),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("could not create request: %w", err)
-	}
-	req.Header.Set("X-Forwarded-Proto", "https")
-	client := http.Client{
-		Timeout: time.Second * 5,
-	}
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http request failed: %w", err)
-	}
-	if err = determineError(res); err != nil {
-		return nil, fmt.Errorf("unexpected response: %w", err)
-	}
-	return res, nil
+	defer metrics.ObserveHydraRequest(flow, action, time.Now())
+	return c.send(ctx, func() (*http.Request, error) {
+		return http.NewRequestWithContext(
+			ctx,
+			http.MethodPut,
+			fmt.Sprintf("%s/oauth2/auth/requests/%s/%s?%s", c.hydraPrivateURL, flow, action, params.Encode()),
+			bytes.NewReader(body),
+		)
+	})
+}
+
+// Get is like GetContext using the background context.
+//
+// Deprecated: use GetContext, so the request can be cancelled.
+func (c *Client) Get(flow, challenge string) (*http.Response, error) {
+	return c.GetContext(context.Background(), flow, challenge)
+}
+
+// Put is like PutContext using the background context.
+//
+// Deprecated: use PutContext, so the request can be cancelled.
+func (c *Client) Put(flow, action, challenge string, body []byte) (*http.Response, error) {
+	return c.PutContext(context.Background(), flow, action, challenge, body)
 }
@@ -1,10 +1,19 @@
 package hydraclient
 
+import "context"
+
 // GetLoginRequestResponse represents a response from a
 // GetLoginRequest.
 type GetLoginRequestResponse interface {
+	GetChallenge() string
 	GetSkip() bool
 	GetSubject() string
+	GetClient() OAuth2Client
+	GetRequestURL() string
+	GetRequestedScope() []string
+	GetRequestedAccessTokenAudience() []string
+	GetSessionID() string
+	GetOIDCContext() OIDCContext
 }
 
 // AcceptLoginRequestResponse represents a response from a
@@ -22,9 +31,19 @@ type RejectLoginRequestResponse interface {
 // GetConsentRequestResponse represents a response from a
 // GetConsentRequest.
 type GetConsentRequestResponse interface {
+	GetChallenge() string
+	GetSkip() bool
 	GetSubject() string
+	GetClient() OAuth2Client
+	GetRequestURL() string
 	GetRequestedScope() []string
 	GetRequestedAccessTokenAudience() []string
+	GetLoginChallenge() string
+	GetLoginSessionID() string
+	GetACR() string
+	GetAMR() []string
+	GetOIDCContext() OIDCContext
+	GetContext() map[string]interface{}
 }
 
 // AcceptConsentRequestResponse represents a response from a
@@ -33,10 +52,23 @@ type AcceptConsentRequestResponse interface {
 	GetRedirectTo() string
 }
 
+// RejectConsentRequestResponse represents a response from a
+// RejectConsentRequest.
+type RejectConsentRequestResponse interface {
+	GetRedirectTo() string
+}
+
 // GetLogoutRequestResponse represents a response from a
 // GetLogoutRequest.
 type GetLogoutRequestResponse interface {
+	GetChallenge() string
 	GetSubject() string
+	GetSessionID() string
+	GetRequestURL() string
+	GetRPInitiated() bool
+	// GetClient returns the client which initiated the
+	// logout or nil if the logout was not rp initiated.
+	GetClient() *OAuth2Client
 }
 
 // AcceptLogoutRequestResponse represents a response from a
@@ -48,11 +80,13 @@ type AcceptLogoutRequestResponse interface {
 // HydraClient describe all client functions
 // to interact with hydra.
 type HydraClient interface {
-	GetLoginRequest(challenge string) (GetLoginRequestResponse, error)
-	AcceptLoginRequest(challenge string, remember bool, rememberFor int, subject string) (AcceptLoginRequestResponse, error)
-	RejectLoginRequest(challenge string, errorID string, errorDescription string) (RejectLoginRequestResponse, error)
-	GetConsentRequest(challenge string) (GetConsentRequestResponse, error)
-	AcceptConsentRequest(challenge string, remember bool, rememberFor int, grantScope []string, grantAccessTokenAudience []string) (AcceptConsentRequestResponse, error)
-	GetLogoutRequest(challenge string) (GetLogoutRequestResponse, error)
-	AcceptLogoutRequest(challenge string) (AcceptLogoutRequestResponse, error)
+	GetLoginRequestContext(ctx context.Context, challenge string) (GetLoginRequestResponse, error)
+	AcceptLoginRequestContext(ctx context.Context, challenge string, remember bool, rememberFor int, subject string, acr string, amr []string) (AcceptLoginRequestResponse, error)
+	RejectLoginRequestContext(ctx context.Context, challenge string, errorID string, errorDescription string) (RejectLoginRequestResponse, error)
+	GetConsentRequestContext(ctx context.Context, challenge string) (GetConsentRequestResponse, error)
+	AcceptConsentRequestContext(ctx context.Context, challenge string, remember bool, rememberFor int, grantScope []string, grantAccessTokenAudience []string, session ConsentSession) (AcceptConsentRequestResponse, error)
+	RejectConsentRequestContext(ctx context.Context, challenge string, errorID string, errorDescription string) (RejectConsentRequestResponse, error)
+	GetLogoutRequestContext(ctx context.Context, challenge string) (GetLogoutRequestResponse, error)
+	AcceptLogoutRequestContext(ctx context.Context, challenge string) (AcceptLogoutRequestResponse, error)
+	Ready(ctx context.Context) error
 }
@@ -0,0 +1,146 @@
+package hydraclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"time"
+)
+
+// SetTimeout sets the time limit for requests to hydra,
+// including connecting and reading the response.
+func SetTimeout(timeout time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid timeout: %v", timeout)
+		}
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// SetConnectionPool sets the number of idle connections to
+// hydra kept open and how long they are kept before closing.
+func SetConnectionPool(maxIdleConns int, idleConnTimeout time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		if maxIdleConns < 0 {
+			return fmt.Errorf("invalid number of idle connections: %v", maxIdleConns)
+		}
+		if idleConnTimeout < 0 {
+			return fmt.Errorf("invalid idle connection timeout: %v", idleConnTimeout)
+		}
+		c.maxIdleConns = maxIdleConns
+		c.idleConnTimeout = idleConnTimeout
+		return nil
+	}
+}
+
+// SetRetries sets how often failed requests to hydra are retried
+// and the delay before the first retry, which doubles for each retry.
+// Only network errors and server errors are retried.
+func SetRetries(retries int, delay time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		if retries < 0 {
+			return fmt.Errorf("invalid number of retries: %v", retries)
+		}
+		if delay < 0 {
+			return fmt.Errorf("invalid retry delay: %v", delay)
+		}
+		c.retries = retries
+		c.retryDelay = delay
+		return nil
+	}
+}
+
+// SetCircuitBreaker sets after how many failed calls in a row requests
+// to hydra fail fast and for how long, before hydra is tried again.
+// A threshold of 0 disables the circuit breaker.
+func SetCircuitBreaker(threshold int, cooldown time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		if threshold < 0 {
+			return fmt.Errorf("invalid circuit breaker threshold: %v", threshold)
+		}
+		if threshold > 0 && cooldown <= 0 {
+			return fmt.Errorf("invalid circuit breaker cooldown: %v", cooldown)
+		}
+		c.breaker = nil
+		if threshold > 0 {
+			c.breaker = &breaker{threshold: threshold, cooldown: cooldown}
+		}
+		return nil
+	}
+}
+
+// SetCAFile sets the pem encoded ca certificates used to verify
+// hydra's certificate instead of the system's certificates.
+func SetCAFile(path string) func(*Client) error {
+	return func(c *Client) error {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return fmt.Errorf("no certificates found in ca file %s", path)
+		}
+		c.tls().RootCAs = pool
+		return nil
+	}
+}
+
+// SetClientCertificate sets the pem encoded certificate and key
+// presented to hydra, if it requires mutual tls.
+func SetClientCertificate(certFile string, keyFile string) func(*Client) error {
+	return func(c *Client) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		c.tls().Certificates = []tls.Certificate{cert}
+		return nil
+	}
+}
+
+// SetBearerToken sets the token sent in the authorization header,
+// such as for a gateway protecting hydra's admin api.
+func SetBearerToken(token string) func(*Client) error {
+	return func(c *Client) error {
+		if token == "" {
+			return fmt.Errorf("empty bearer token given")
+		}
+		c.authorization = "Bearer " + token
+		return nil
+	}
+}
+
+// SetBasicAuth sets the credentials sent in the authorization header,
+// such as for a gateway protecting hydra's admin api.
+func SetBasicAuth(username string, password string) func(*Client) error {
+	return func(c *Client) error {
+		if username == "" {
+			return fmt.Errorf("empty basic auth username given")
+		}
+		c.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+		return nil
+	}
+}
+
+// SetForwardedProto sets the value of the X-Forwarded-Proto header.
+// An empty value omits the header, which is needed if hydra's
+// admin api is served using tls or by a proxy overwriting it.
+func SetForwardedProto(proto string) func(*Client) error {
+	return func(c *Client) error {
+		c.forwardedProto = proto
+		return nil
+	}
+}
+
+// tls returns the tls configuration of the client, creating it if needed.
+func (c *Client) tls() *tls.Config {
+	if c.tlsConfig == nil {
+		c.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return c.tlsConfig
+}
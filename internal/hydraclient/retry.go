@@ -0,0 +1,170 @@
+package hydraclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrUnavailable is returned if hydra could not be reached or kept
+// failing after all retries, or if the circuit breaker is open.
+var ErrUnavailable = errors.New("hydra is unavailable")
+
+// Error is an error response of hydra's admin api.
+type Error struct {
+	Name        string `json:"error"`
+	Description string `json:"error_description"`
+	Debug       string `json:"error_debug"`
+	StatusCode  int    `json:"status_code"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v; %s; %s; %s", e.StatusCode, e.Name, e.Description, e.Debug)
+}
+
+// transient reports if the request failing with the given
+// error should be retried. Only network errors and server
+// errors are retried, hydra's answer to all other errors
+// would not change.
+func transient(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) {
+		return true
+	}
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
+
+// backoff returns the jittered delay before the given retry.
+// The delay doubles with every retry, a random half of it is
+// dropped so concurrent requests do not retry at the same time.
+func backoff(base time.Duration, retry int) time.Duration {
+	d := base << (retry - 1)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// breaker is a circuit breaker failing requests to hydra fast,
+// after the given number of requests in a row have failed.
+// Once the cooldown has passed, a single request is let through.
+// If it succeeds, the breaker closes again, otherwise it stays open
+// for another cooldown.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow returns ErrUnavailable if the breaker is open.
+func (b *breaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return nil
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return fmt.Errorf("%w: circuit breaker is open", ErrUnavailable)
+	}
+	b.probing = true
+	return nil
+}
+
+// release lets the next request through, if the request let
+// through by allow was cancelled without a result.
+func (b *breaker) release() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// record counts the result of a request let through by allow.
+func (b *breaker) record(ok bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if ok {
+		if b.failures >= b.threshold {
+			slog.Info("hydra is available again, closing circuit breaker")
+		}
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+		slog.Warn("hydra is unavailable, opening circuit breaker", "failures", b.failures, "cooldown", b.cooldown)
+	}
+}
+
+// send sends the request created by newRequest to hydra and returns
+// the response if it was successful. Transient failures are retried
+// until the context is done, so newRequest needs to create a new
+// request for every attempt.
+func (c Client) send(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+	var err error
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			t := time.NewTimer(backoff(c.retryDelay, attempt))
+			select {
+			case <-ctx.Done():
+				t.Stop()
+			case <-t.C:
+			}
+		}
+		if ctx.Err() != nil {
+			// the caller gave up, which says nothing about hydra
+			c.breaker.release()
+			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+		}
+		var req *http.Request
+		req, err = newRequest()
+		if err != nil {
+			c.breaker.record(true)
+			return nil, fmt.Errorf("could not create request: %w", err)
+		}
+		var res *http.Response
+		res, err = c.do(req)
+		if err != nil {
+			err = fmt.Errorf("http request failed: %w", err)
+			continue
+		}
+		if err = determineError(res); err != nil {
+			res.Body.Close()
+			err = fmt.Errorf("unexpected response: %w", err)
+			if !transient(err) {
+				c.breaker.record(true)
+				return nil, err
+			}
+			continue
+		}
+		c.breaker.record(true)
+		return res, nil
+	}
+	if ctx.Err() != nil {
+		c.breaker.release()
+		return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+	}
+	c.breaker.record(false)
+	return nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+}
@@ -0,0 +1,98 @@
+package hydraclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "network error", err: errors.New("connection refused"), want: true},
+		{name: "server error", err: &Error{StatusCode: http.StatusInternalServerError}, want: true},
+		{name: "unavailable", err: &Error{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "too many requests", err: &Error{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "wrapped server error", err: fmt.Errorf("unexpected response: %w", &Error{StatusCode: http.StatusBadGateway}), want: true},
+		{name: "not found", err: &Error{StatusCode: http.StatusNotFound}, want: false},
+		{name: "conflict", err: fmt.Errorf("unexpected response: %w", &Error{StatusCode: http.StatusConflict}), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transient(tt.err); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	base := 100 * time.Millisecond
+	for retry := 1; retry <= 4; retry++ {
+		max := base << (retry - 1)
+		for i := 0; i < 100; i++ {
+			if d := backoff(base, retry); d < max/2 || d > max {
+				t.Fatalf("retry %v: expected a delay between %v and %v, got %v", retry, max/2, max, d)
+			}
+		}
+	}
+	if d := backoff(0, 1); d != 0 {
+		t.Fatalf("expected no delay without base delay, got %v", d)
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	b := &breaker{threshold: 2, cooldown: 50 * time.Millisecond}
+	for i := 0; i < 2; i++ {
+		if err := b.allow(); err != nil {
+			t.Fatalf("request %v: expected the breaker to be closed, got %v", i+1, err)
+		}
+		b.record(false)
+	}
+	if err := b.allow(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected the breaker to be open, got %v", err)
+	}
+	time.Sleep(b.cooldown)
+	// a single request is let through after the cooldown
+	if err := b.allow(); err != nil {
+		t.Fatalf("expected a probing request, got %v", err)
+	}
+	if err := b.allow(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected a single probing request, got %v", err)
+	}
+	// a cancelled probe lets the next request through
+	b.release()
+	if err := b.allow(); err != nil {
+		t.Fatalf("expected a probing request after release, got %v", err)
+	}
+	// a failed probe keeps the breaker open for another cooldown
+	b.record(false)
+	if err := b.allow(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected the breaker to stay open, got %v", err)
+	}
+	time.Sleep(b.cooldown)
+	if err := b.allow(); err != nil {
+		t.Fatalf("expected a probing request, got %v", err)
+	}
+	// a successful probe closes the breaker
+	b.record(true)
+	for i := 0; i < 2; i++ {
+		if err := b.allow(); err != nil {
+			t.Fatalf("expected the breaker to be closed, got %v", err)
+		}
+	}
+}
+
+func TestNilBreaker(t *testing.T) {
+	var b *breaker
+	if err := b.allow(); err != nil {
+		t.Fatalf("expected a disabled breaker to allow requests, got %v", err)
+	}
+	b.record(false)
+	b.release()
+}
@@ -0,0 +1,32 @@
+package hydraclient
+
+// OAuth2Client represents the oauth2 client
+// which initiated a login, consent or logout request.
+type OAuth2Client struct {
+	ClientID                string                 `json:"client_id"`
+	ClientName              string                 `json:"client_name"`
+	ClientURI               string                 `json:"client_uri"`
+	LogoURI                 string                 `json:"logo_uri"`
+	PolicyURI               string                 `json:"policy_uri"`
+	TosURI                  string                 `json:"tos_uri"`
+	Owner                   string                 `json:"owner"`
+	Contacts                []string               `json:"contacts"`
+	Scope                   string                 `json:"scope"`
+	Audience                []string               `json:"audience"`
+	RedirectURIs            []string               `json:"redirect_uris"`
+	GrantTypes              []string               `json:"grant_types"`
+	ResponseTypes           []string               `json:"response_types"`
+	SubjectType             string                 `json:"subject_type"`
+	TokenEndpointAuthMethod string                 `json:"token_endpoint_auth_method"`
+	Metadata                map[string]interface{} `json:"metadata"`
+}
+
+// OIDCContext contains the openid connect parameters
+// of the authorization request.
+type OIDCContext struct {
+	ACRValues         []string               `json:"acr_values"`
+	Display           string                 `json:"display"`
+	IDTokenHintClaims map[string]interface{} `json:"id_token_hint_claims"`
+	LoginHint         string                 `json:"login_hint"`
+	UILocales         []string               `json:"ui_locales"`
+}
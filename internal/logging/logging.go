@@ -0,0 +1,97 @@
+// Package logging provides the structured, leveled logger of godra.
+//
+// Attributes such as the request id or the login challenge are
+// stored in the context and added to every record logged with it:
+//
+//	ctx = logging.With(ctx, "login_challenge", challenge)
+//	slog.InfoContext(ctx, "login accepted", "subject", subject)
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// ctxKey is the key of the attributes stored in a context.
+type ctxKey struct{}
+
+// New creates a logger writing records of at least the given level
+// ("debug", "info", "warn" or "error") to w, using the given format
+// ("json", "logfmt" or its alias "text"). Attributes stored in the context using With
+// are added to the records.
+func New(w io.Writer, format string, level string) (*slog.Logger, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level '%s', expected debug, info, warn or error", level)
+	}
+	opts := &slog.HandlerOptions{Level: l}
+	var h slog.Handler
+	switch strings.ToLower(format) {
+	case "json":
+		h = slog.NewJSONHandler(w, opts)
+	case "logfmt", "text":
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("invalid log format '%s', expected json, logfmt or text", format)
+	}
+	return slog.New(contextHandler{Handler: h}), nil
+}
+
+// With returns a copy of the context carrying the given attributes,
+// given as alternating keys and values like for slog.Logger.With.
+// Attributes with the same key replace the existing ones.
+func With(ctx context.Context, args ...interface{}) context.Context {
+	r := slog.NewRecord(time.Time{}, 0, "", 0)
+	r.Add(args...)
+	attrs := append([]slog.Attr{}, attributes(ctx)...)
+	r.Attrs(func(a slog.Attr) bool {
+		for i := range attrs {
+			if attrs[i].Key == a.Key {
+				attrs[i] = a
+				return true
+			}
+		}
+		attrs = append(attrs, a)
+		return true
+	})
+	return context.WithValue(ctx, ctxKey{}, attrs)
+}
+
+// attributes returns the attributes stored in the context.
+func attributes(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(ctxKey{}).([]slog.Attr)
+	return attrs
+}
+
+// contextHandler adds the attributes stored in
+// the context to the records of the wrapped handler.
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle adds the attributes stored in the context
+// to the record and passes it to the wrapped handler.
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs := attributes(ctx); len(attrs) > 0 {
+		r = r.Clone()
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler with the given attributes.
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns a handler with the given group.
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,227 @@
+package mail
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sender describes how mails are sent.
+type Sender interface {
+	Send(to string, subject string, body string) error
+}
+
+// SMTP implements the sender interface,
+// delivering mails to a smtp server.
+type SMTP struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+}
+
+var _ Sender = &SMTP{}
+
+// NewSMTPSender creates a new smtp sender.
+// It takes functional parameters to change default options
+// such as the smtp host.
+// It returns the newly created sender or an error if
+// something went wrong.
+func NewSMTPSender(opts ...func(*SMTP) error) (*SMTP, error) {
+	// create sender with default options
+	var s = SMTP{
+		host:    "localhost",
+		port:    587,
+		from:    "godra@localhost",
+		timeout: 10 * time.Second,
+	}
+	// run functional options
+	for _, op := range opts {
+		err := op(&s)
+		if err != nil {
+			return nil, fmt.Errorf("setting smtp option failed: %w", err)
+		}
+	}
+	return &s, nil
+}
+
+// SetHost changes the host and port of the smtp server.
+func SetHost(host string, port int) func(*SMTP) error {
+	return func(s *SMTP) error {
+		if host == "" {
+			return fmt.Errorf("empty smtp host given")
+		}
+		if port <= 0 {
+			return fmt.Errorf("invalid smtp port: %v", port)
+		}
+		s.host = host
+		s.port = port
+		return nil
+	}
+}
+
+// SetCredentials sets the username and password
+// used to authenticate against the smtp server.
+func SetCredentials(username string, password string) func(*SMTP) error {
+	return func(s *SMTP) error {
+		s.username = username
+		s.password = password
+		return nil
+	}
+}
+
+// SetFrom changes the sender address.
+func SetFrom(from string) func(*SMTP) error {
+	return func(s *SMTP) error {
+		if from == "" {
+			return fmt.Errorf("empty sender address given")
+		}
+		s.from = from
+		return nil
+	}
+}
+
+// SetTimeout changes how long connecting to the
+// smtp server and delivering a mail may take.
+func SetTimeout(d time.Duration) func(*SMTP) error {
+	return func(s *SMTP) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid smtp timeout: %v", d)
+		}
+		s.timeout = d
+		return nil
+	}
+}
+
+// Send delivers the mail to the smtp server.
+// STARTTLS is used if the server supports it.
+// The whole delivery is bounded by the configured timeout.
+func (s *SMTP) Send(to string, subject string, body string) error {
+	if err := s.send(to, message(s.from, to, subject, body)); err != nil {
+		return fmt.Errorf("sending mail to %s failed: %w", to, err)
+	}
+	return nil
+}
+
+// send delivers the message using a single smtp connection.
+func (s *SMTP) send(to string, msg []byte) error {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(s.host, fmt.Sprint(s.port)), s.timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp server does not support authentication")
+		}
+		if err = c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(s.from); err != nil {
+		return err
+	}
+	if err = c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// Log implements the sender interface,
+// writing mails to the log instead of sending them.
+// It is meant for local testing.
+type Log struct{}
+
+var _ Sender = Log{}
+
+// NewLogSender creates a new sender which writes mails to the log.
+func NewLogSender() Log {
+	return Log{}
+}
+
+// Send writes the mail to the log.
+func (Log) Send(to string, subject string, body string) error {
+	slog.Info("mail", "to", to, "subject", subject, "body", body)
+	return nil
+}
+
+// File implements the sender interface,
+// appending mails to a file instead of sending them.
+// It is meant for local testing.
+type File struct {
+	path string
+	mu   *sync.Mutex
+}
+
+var _ Sender = File{}
+
+// NewFileSender creates a new sender which
+// appends mails to the file with the given path.
+func NewFileSender(path string) (File, error) {
+	if path == "" {
+		return File{}, fmt.Errorf("empty mail file path given")
+	}
+	return File{path: path, mu: &sync.Mutex{}}, nil
+}
+
+// Send appends the mail to the file.
+func (f File) Send(to string, subject string, body string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open mail file: %w", err)
+	}
+	defer file.Close()
+	if _, err = file.Write(append(message("godra", to, subject, body), '\n')); err != nil {
+		return fmt.Errorf("unable to write mail file: %w", err)
+	}
+	return nil
+}
+
+// message creates a plain text mail message.
+func message(from string, to string, subject string, body string) []byte {
+	var b strings.Builder
+	// prevent header injection
+	clean := strings.NewReplacer("\r", "", "\n", "")
+	fmt.Fprintf(&b, "From: %s\r\n", clean.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", clean.Replace(to))
+	fmt.Fprintf(&b, "Subject: %s\r\n", clean.Replace(subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+)
+
+// database records the latency of the lookups of the wrapped database.
+type database struct {
+	db.Database
+}
+
+var _ db.PasswordValidator = database{}
+var _ db.ReadOnlyDatabase = database{}
+
+// InstrumentDatabase wraps the given database, so the latency
+// of its lookups is recorded. All other methods are passed through.
+func InstrumentDatabase(d db.Database) db.Database {
+	return database{Database: d}
+}
+
+// observeLookup records the latency of a lookup started at the given time.
+func observeLookup(operation string, start time.Time) {
+	databaseDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// FindUserByUsernameOrMail searches for a user which has the given string as username or mail address.
+func (d database) FindUserByUsernameOrMail(ctx context.Context, s string) (*db.User, error) {
+	defer observeLookup("find_user_by_username_or_mail", time.Now())
+	return d.Database.FindUserByUsernameOrMail(ctx, s)
+}
+
+// FindUserByID searches for a user with the given id.
+func (d database) FindUserByID(ctx context.Context, id string) (*db.User, error) {
+	defer observeLookup("find_user_by_id", time.Now())
+	return d.Database.FindUserByID(ctx, id)
+}
+
+// FindUsers returns the users ordered by id.
+func (d database) FindUsers(ctx context.Context, skip int, limit int) ([]db.User, error) {
+	defer observeLookup("find_users", time.Now())
+	return d.Database.FindUsers(ctx, skip, limit)
+}
+
+// FindLoginThrottle searches for the failed login attempts with the given key.
+func (d database) FindLoginThrottle(ctx context.Context, key string) (*db.LoginThrottle, error) {
+	defer observeLookup("find_login_throttle", time.Now())
+	return d.Database.FindLoginThrottle(ctx, key)
+}
+
+// FindLoginThrottles returns all failed login attempts.
+func (d database) FindLoginThrottles(ctx context.Context) ([]db.LoginThrottle, error) {
+	defer observeLookup("find_login_throttles", time.Now())
+	return d.Database.FindLoginThrottles(ctx)
+}
+
+// FindPasswordReset searches for the password reset request with the given id.
+func (d database) FindPasswordReset(ctx context.Context, id string) (*db.PasswordReset, error) {
+	defer observeLookup("find_password_reset", time.Now())
+	return d.Database.FindPasswordReset(ctx, id)
+}
+
+// ValidatePassword validates the password using the wrapped database,
+// so databases implementing db.PasswordValidator keep working.
+func (d database) ValidatePassword(ctx context.Context, u *db.User, plainPassword string) error {
+	return db.CheckPassword(ctx, d.Database, u, plainPassword)
+}
+
+// ReadOnly reports if the wrapped database is read-only.
+func (d database) ReadOnly() bool {
+	return db.IsReadOnly(d.Database)
+}
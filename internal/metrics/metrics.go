@@ -0,0 +1,127 @@
+// Package metrics provides the prometheus metrics of godra.
+//
+// The metrics are registered on a separate registry, which
+// is served by Handler together with the go runtime
+// and process metrics.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// outcomes of login attempts.
+const (
+	LoginSuccess      = "success"
+	LoginBadPassword  = "bad_password"
+	LoginUnknownUser  = "unknown_user"
+	LoginCancelled    = "cancelled"
+	LoginSkipAccepted = "skip_accepted"
+)
+
+// outcomes of consent requests.
+const (
+	ConsentAccepted = "accepted"
+	ConsentRejected = "rejected"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	loginAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godra",
+		Name:      "login_attempts_total",
+		Help:      "Number of login attempts by outcome.",
+	}, []string{"outcome"})
+
+	consents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godra",
+		Name:      "consents_total",
+		Help:      "Number of consent requests by outcome.",
+	}, []string{"outcome"})
+
+	logouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "godra",
+		Name:      "logouts_total",
+		Help:      "Number of accepted logout requests.",
+	})
+
+	hydraDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "godra",
+		Name:      "hydra_request_duration_seconds",
+		Help:      "Latency of requests to hydra's admin api by flow and action.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"flow", "action"})
+
+	databaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "godra",
+		Name:      "database_lookup_duration_seconds",
+		Help:      "Latency of database lookups by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "godra",
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of http requests by route, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		loginAttempts,
+		consents,
+		logouts,
+		hydraDuration,
+		databaseDuration,
+		httpDuration,
+	)
+	// initialize the labels, so all outcomes are exported from the start
+	for _, o := range []string{LoginSuccess, LoginBadPassword, LoginUnknownUser, LoginCancelled, LoginSkipAccepted} {
+		loginAttempts.WithLabelValues(o)
+	}
+	for _, o := range []string{ConsentAccepted, ConsentRejected} {
+		consents.WithLabelValues(o)
+	}
+}
+
+// Handler returns the handler serving the metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// LoginAttempt counts a login attempt with the given outcome.
+func LoginAttempt(outcome string) {
+	loginAttempts.WithLabelValues(outcome).Inc()
+}
+
+// Consent counts a consent request with the given outcome.
+func Consent(outcome string) {
+	consents.WithLabelValues(outcome).Inc()
+}
+
+// Logout counts an accepted logout request.
+func Logout() {
+	logouts.Inc()
+}
+
+// ObserveHydraRequest records the latency of a request to
+// hydra's admin api, which was started at the given time.
+func ObserveHydraRequest(flow string, action string, start time.Time) {
+	hydraDuration.WithLabelValues(flow, action).Observe(time.Since(start).Seconds())
+}
+
+// InstrumentHandler records the duration of the requests
+// handled by the given handler, labeled with the given route.
+// The route should be the pattern of the handler, so
+// the number of label values stays bounded.
+func InstrumentHandler(route string, h http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerDuration(httpDuration.MustCurryWith(prometheus.Labels{"route": route}), h)
+}
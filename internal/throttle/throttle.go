@@ -0,0 +1,277 @@
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+)
+
+// Throttler limits failed login attempts.
+// Failures are counted per key (for example per user and per
+// client ip address) and stored in the database, so all replicas
+// share the same state.
+// After a number of free attempts, each further failure blocks
+// logins for an exponentially growing delay. Once the lockout
+// threshold is reached, logins are blocked for the lockout duration.
+type Throttler struct {
+	db              db.Database
+	freeAttempts    int
+	baseDelay       time.Duration
+	maxDelay        time.Duration
+	lockoutAttempts int
+	lockoutDuration time.Duration
+	window          time.Duration
+}
+
+// New creates a new throttler, storing its state in the given database.
+// It takes functional parameters to change default options
+// such as the number of free attempts.
+// It returns the newly created throttler or an error if
+// something went wrong.
+func New(database db.Database, opts ...func(*Throttler) error) (*Throttler, error) {
+	if database == nil {
+		return nil, fmt.Errorf("no database given")
+	}
+	// create throttler with default options
+	var t = Throttler{
+		db:              database,
+		freeAttempts:    3,
+		baseDelay:       time.Second,
+		maxDelay:        5 * time.Minute,
+		lockoutAttempts: 10,
+		lockoutDuration: 30 * time.Minute,
+		window:          time.Hour,
+	}
+	// run functional options
+	for _, op := range opts {
+		err := op(&t)
+		if err != nil {
+			return nil, fmt.Errorf("setting throttle option failed: %w", err)
+		}
+	}
+	return &t, nil
+}
+
+// SetFreeAttempts changes the number of failed attempts
+// which are allowed before logins get delayed.
+// The default is 3.
+func SetFreeAttempts(n int) func(*Throttler) error {
+	return func(t *Throttler) error {
+		if n < 0 {
+			return fmt.Errorf("invalid number of free attempts: %v", n)
+		}
+		t.freeAttempts = n
+		return nil
+	}
+}
+
+// SetDelay changes the delay after the first failure exceeding the
+// free attempts and the maximum delay. The delay doubles with each failure.
+// The defaults are one second and five minutes.
+func SetDelay(base time.Duration, max time.Duration) func(*Throttler) error {
+	return func(t *Throttler) error {
+		if base <= 0 || max < base {
+			return fmt.Errorf("invalid delay: %v - %v", base, max)
+		}
+		t.baseDelay = base
+		t.maxDelay = max
+		return nil
+	}
+}
+
+// SetLockout changes the number of failed attempts after which
+// logins are locked and the duration of the lockout.
+// Zero attempts disable the lockout, the delays are still applied.
+// The defaults are 10 attempts and 30 minutes.
+func SetLockout(attempts int, duration time.Duration) func(*Throttler) error {
+	return func(t *Throttler) error {
+		if attempts < 0 || (attempts > 0 && duration <= 0) {
+			return fmt.Errorf("invalid lockout: %v attempts, %v", attempts, duration)
+		}
+		t.lockoutAttempts = attempts
+		t.lockoutDuration = duration
+		return nil
+	}
+}
+
+// SetWindow changes the duration after which failed attempts
+// are forgotten if no further failures occurred.
+// The default is one hour.
+func SetWindow(window time.Duration) func(*Throttler) error {
+	return func(t *Throttler) error {
+		if window <= 0 {
+			return fmt.Errorf("invalid window: %v", window)
+		}
+		t.window = window
+		return nil
+	}
+}
+
+// UserKey returns the throttle key for the user with the given id.
+func UserKey(id string) string {
+	return "user:" + id
+}
+
+// TOTPKey returns the key counting the invalid one-time
+// passwords entered for the given login challenge.
+func TOTPKey(challenge string) string {
+	return "totp:" + challenge
+}
+
+// ResetIPKey returns the key counting the password
+// reset requests sent from the given client ip address.
+func ResetIPKey(ip string) string {
+	return "reset-ip:" + ip
+}
+
+// ResetUserKey returns the key counting the password reset
+// requests for the given username or mail address.
+func ResetUserKey(name string) string {
+	return "reset-user:" + strings.ToLower(name)
+}
+
+// IPKey returns the throttle key for the given client ip address.
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// ValidKey returns true if the given key was
+// created using one of the key functions.
+func ValidKey(key string) bool {
+	for _, prefix := range []string{UserKey(""), IPKey(""), TOTPKey(""), ResetIPKey(""), ResetUserKey("")} {
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attempt registers a login attempt for all the given keys, which
+// counts as failed unless it is released using Release or the
+// failures are removed using Reset. The attempt is counted before
+// it is made and the delay is decided from the returned count,
+// so parallel attempts cannot bypass the delays.
+// If logins using any of the keys are blocked, the attempt is refused
+// and the duration for which the logins are blocked is returned.
+func (t *Throttler) Attempt(ctx context.Context, keys ...string) (time.Duration, error) {
+	var wait time.Duration
+	for _, k := range keys {
+		lt, err := t.db.FindLoginThrottle(ctx, k)
+		if err != nil {
+			return 0, fmt.Errorf("unable to find login throttle '%s': %w", k, err)
+		}
+		if d := time.Until(lt.BlockedUntil); d > wait {
+			wait = d
+		}
+		// forget failures which happened a long time ago
+		if lt.Failures > 0 && !lt.Blocked() && time.Since(lt.LastFailure) > t.window {
+			if err = t.db.DeleteLoginThrottle(ctx, k); err != nil {
+				return 0, fmt.Errorf("unable to reset login throttle '%s': %w", k, err)
+			}
+		}
+	}
+	if wait > 0 {
+		return wait, nil
+	}
+	for _, k := range keys {
+		lt, err := t.db.IncrementLoginFailures(ctx, k)
+		if err != nil {
+			return 0, fmt.Errorf("unable to register login attempt for '%s': %w", k, err)
+		}
+		// blocked by a parallel attempt
+		if d := time.Until(lt.BlockedUntil); d > 0 {
+			if d > wait {
+				wait = d
+			}
+			continue
+		}
+		d := t.delay(lt.Failures)
+		if d == 0 {
+			continue
+		}
+		if t.lockoutAttempts > 0 && lt.Failures >= t.lockoutAttempts {
+			slog.WarnContext(ctx, "locking logins", "key", k, "duration", d, "failures", lt.Failures)
+		}
+		if err = t.db.BlockLogin(ctx, k, time.Now().Add(d)); err != nil {
+			return 0, fmt.Errorf("unable to block logins for '%s': %w", k, err)
+		}
+	}
+	return wait, nil
+}
+
+// Release removes the login attempts registered for the given keys,
+// because they did not fail. If the remaining failures do not
+// delay logins, the block placed by the attempt is lifted.
+func (t *Throttler) Release(ctx context.Context, keys ...string) error {
+	for _, k := range keys {
+		lt, err := t.db.DecrementLoginFailures(ctx, k)
+		if err != nil {
+			return fmt.Errorf("unable to release login attempt for '%s': %w", k, err)
+		}
+		if lt.Blocked() && t.delay(lt.Failures) == 0 {
+			if err = t.db.BlockLogin(ctx, k, time.Time{}); err != nil {
+				return fmt.Errorf("unable to unblock logins for '%s': %w", k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Reset removes the failed login attempts for all the given keys.
+func (t *Throttler) Reset(ctx context.Context, keys ...string) error {
+	for _, k := range keys {
+		if err := t.db.DeleteLoginThrottle(ctx, k); err != nil {
+			return fmt.Errorf("unable to reset login throttle '%s': %w", k, err)
+		}
+	}
+	return nil
+}
+
+// Blocked returns the failed login attempts
+// of all keys which are blocked at the moment.
+func (t *Throttler) Blocked(ctx context.Context) ([]db.LoginThrottle, error) {
+	all, err := t.db.FindLoginThrottles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find login throttles: %w", err)
+	}
+	var blocked []db.LoginThrottle
+	for _, lt := range all {
+		if lt.Blocked() {
+			blocked = append(blocked, lt)
+		}
+	}
+	return blocked, nil
+}
+
+// delay calculates for how long logins are
+// blocked after the given number of failures.
+func (t *Throttler) delay(failures int) time.Duration {
+	if t.lockoutAttempts > 0 && failures >= t.lockoutAttempts {
+		return t.lockoutDuration
+	}
+	if failures <= t.freeAttempts {
+		return 0
+	}
+	d := t.baseDelay
+	for i := t.freeAttempts + 1; i < failures; i++ {
+		d *= 2
+		if d >= t.maxDelay {
+			return t.maxDelay
+		}
+	}
+	return d
+}
+
+// Message returns the message shown to users whose logins
+// are blocked for the given duration.
+func Message(wait time.Duration) string {
+	wait = wait.Round(time.Second)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return fmt.Sprintf("Too many failed login attempts, please try again in %s.", wait)
+}
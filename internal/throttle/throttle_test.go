@@ -0,0 +1,129 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rbicker/godra/internal/db"
+)
+
+func TestDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		lockout  int
+		failures int
+		want     time.Duration
+	}{
+		{name: "free attempt", lockout: 10, failures: 3, want: 0},
+		{name: "first delay", lockout: 10, failures: 4, want: time.Second},
+		{name: "doubled delay", lockout: 10, failures: 6, want: 4 * time.Second},
+		{name: "maximum delay", lockout: 10, failures: 9, want: 10 * time.Second},
+		{name: "lockout", lockout: 10, failures: 10, want: time.Hour},
+		{name: "lockout disabled", lockout: 0, failures: 100, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := &Throttler{}
+			for _, op := range []func(*Throttler) error{
+				SetFreeAttempts(3),
+				SetDelay(time.Second, 10*time.Second),
+				SetLockout(tt.lockout, time.Hour),
+			} {
+				if err := op(th); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := th.delay(tt.failures); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSetLockout(t *testing.T) {
+	for _, tt := range []struct {
+		attempts int
+		duration time.Duration
+		wantErr  bool
+	}{
+		{attempts: 10, duration: time.Minute},
+		{attempts: 0, duration: 0},
+		{attempts: -1, duration: time.Minute, wantErr: true},
+		{attempts: 10, duration: 0, wantErr: true},
+	} {
+		if err := SetLockout(tt.attempts, tt.duration)(&Throttler{}); (err != nil) != tt.wantErr {
+			t.Fatalf("%v attempts, %v: expected error %v, got %v", tt.attempts, tt.duration, tt.wantErr, err)
+		}
+	}
+}
+
+// newTestThrottler creates a throttler using an in-memory database.
+func newTestThrottler(t *testing.T, opts ...func(*Throttler) error) *Throttler {
+	t.Helper()
+	d, _ := db.NewMemoryDatabase()
+	th, err := New(d, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return th
+}
+
+func TestAttempt(t *testing.T) {
+	ctx := context.Background()
+	th := newTestThrottler(t, SetFreeAttempts(1), SetDelay(time.Minute, time.Hour))
+	for i := 1; i <= 2; i++ {
+		if wait, err := th.Attempt(ctx, "user:alice"); err != nil || wait != 0 {
+			t.Fatalf("attempt %v: expected no delay, got %v, %v", i, wait, err)
+		}
+	}
+	// the second failure delays further attempts
+	if wait, err := th.Attempt(ctx, "user:alice"); err != nil || wait <= 0 {
+		t.Fatalf("expected a delay, got %v, %v", wait, err)
+	}
+	// the refused attempt is not counted
+	lt, err := th.db.FindLoginThrottle(ctx, "user:alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lt.Failures != 2 {
+		t.Fatalf("expected 2 failures, got %v", lt.Failures)
+	}
+	// releasing the attempt lifts the delay it caused
+	if err = th.Release(ctx, "user:alice"); err != nil {
+		t.Fatal(err)
+	}
+	if wait, err := th.Attempt(ctx, "user:alice"); err != nil || wait != 0 {
+		t.Fatalf("expected no delay after releasing the attempt, got %v, %v", wait, err)
+	}
+}
+
+func TestAttemptInParallel(t *testing.T) {
+	th := newTestThrottler(t, SetFreeAttempts(0), SetDelay(time.Minute, time.Hour))
+	var (
+		wg      sync.WaitGroup
+		allowed atomic.Int32
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait, err := th.Attempt(context.Background(), "user:alice")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if wait == 0 {
+				allowed.Add(1)
+				// checking the password takes a while
+				time.Sleep(10 * time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := allowed.Load(); n > 5 {
+		t.Fatalf("expected parallel attempts to be delayed, %v of them were allowed", n)
+	}
+}
@@ -1,6 +1,18 @@
 package utils
 
-import "os"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
 
 // LoadSetting looks up a value from an environment variable.
 // If it is not set, the defaultValue is returned.
@@ -10,3 +22,82 @@ func LoadSetting(name string, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// LoadIntSetting looks up an integer value from an environment variable.
+// If it is not set, the defaultValue is returned.
+// An error is returned if the value cannot be converted.
+func LoadIntSetting(name string, defaultValue int) (int, error) {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer '%s' given for %s", v, name)
+	}
+	return i, nil
+}
+
+// LoadBoolSetting looks up a boolean value from an environment variable.
+// If it is not set, the defaultValue is returned.
+// An error is returned if the value cannot be converted.
+func LoadBoolSetting(name string, defaultValue bool) (bool, error) {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean '%s' given for %s", v, name)
+	}
+	return b, nil
+}
+
+// LoadDurationSetting looks up a duration (such as "5m") from an environment variable.
+// If it is not set, the defaultValue is returned.
+// An error is returned if the value cannot be converted.
+func LoadDurationSetting(name string, defaultValue time.Duration) (time.Duration, error) {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration '%s' given for %s", v, name)
+	}
+	return d, nil
+}
+
+// LoadConfigFile reads the yaml, toml or json file with the given path into v.
+// Yaml and toml files are converted to json first, so v only needs json field tags.
+// Unknown fields are reported as errors.
+func LoadConfigFile(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read config file: %w", err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var data interface{}
+		if err = yaml.Unmarshal(b, &data); err != nil {
+			return fmt.Errorf("unable to parse config file %s: %w", path, err)
+		}
+		if b, err = json.Marshal(data); err != nil {
+			return fmt.Errorf("unable to convert config file %s: %w", path, err)
+		}
+	case ".toml":
+		var data map[string]interface{}
+		if err = toml.Unmarshal(b, &data); err != nil {
+			return fmt.Errorf("unable to parse config file %s: %w", path, err)
+		}
+		if b, err = json.Marshal(data); err != nil {
+			return fmt.Errorf("unable to convert config file %s: %w", path, err)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(v); err != nil {
+		return fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+	return nil
+}
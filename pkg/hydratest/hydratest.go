@@ -0,0 +1,377 @@
+// Package hydratest provides an in-process fake of hydra's admin api
+// for end-to-end tests of the login, consent and logout flows.
+//
+// The login, consent and logout requests are scripted by the test,
+// accept and reject requests are recorded and errors can be injected.
+// godra is pointed to the fake server by setting HYDRA_PRIVATE_URL to
+// its url, preferably with HYDRA_RETRIES and HYDRA_BREAKER_THRESHOLD set
+// to 0, so injected errors reach godra and tests do not influence each other:
+//
+//	h := hydratest.NewServer()
+//	defer h.Close()
+//	challenge := h.AddLoginRequest(hydratest.LoginRequest{
+//		Client: hydratest.OAuth2Client{ClientID: "app"},
+//	})
+//	// ... start godra using h.URL and post the login form using challenge ...
+//	call, ok := h.LastCall("login", "accept")
+package hydratest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// OAuth2Client is the oauth2 client of a request as returned by hydra.
+type OAuth2Client struct {
+	ClientID                string                 `json:"client_id"`
+	ClientName              string                 `json:"client_name"`
+	ClientURI               string                 `json:"client_uri"`
+	LogoURI                 string                 `json:"logo_uri"`
+	PolicyURI               string                 `json:"policy_uri"`
+	TosURI                  string                 `json:"tos_uri"`
+	Owner                   string                 `json:"owner"`
+	Contacts                []string               `json:"contacts"`
+	Scope                   string                 `json:"scope"`
+	Audience                []string               `json:"audience"`
+	RedirectURIs            []string               `json:"redirect_uris"`
+	GrantTypes              []string               `json:"grant_types"`
+	ResponseTypes           []string               `json:"response_types"`
+	SubjectType             string                 `json:"subject_type"`
+	TokenEndpointAuthMethod string                 `json:"token_endpoint_auth_method"`
+	Metadata                map[string]interface{} `json:"metadata"`
+}
+
+// OIDCContext contains the openid connect parameters
+// of the authorization request.
+type OIDCContext struct {
+	ACRValues         []string               `json:"acr_values"`
+	Display           string                 `json:"display"`
+	IDTokenHintClaims map[string]interface{} `json:"id_token_hint_claims"`
+	LoginHint         string                 `json:"login_hint"`
+	UILocales         []string               `json:"ui_locales"`
+}
+
+// ConsentSession contains the claims added to the issued tokens.
+type ConsentSession struct {
+	IDToken     map[string]interface{} `json:"id_token,omitempty"`
+	AccessToken map[string]interface{} `json:"access_token,omitempty"`
+}
+
+// LoginRequest is a login request as returned by hydra.
+type LoginRequest struct {
+	Challenge                    string       `json:"challenge"`
+	Skip                         bool         `json:"skip"`
+	Subject                      string       `json:"subject"`
+	Client                       OAuth2Client `json:"client"`
+	RequestURL                   string       `json:"request_url"`
+	RequestedScope               []string     `json:"requested_scope"`
+	RequestedAccessTokenAudience []string     `json:"requested_access_token_audience"`
+	SessionID                    string       `json:"session_id"`
+	OIDCContext                  OIDCContext  `json:"oidc_context"`
+}
+
+// ConsentRequest is a consent request as returned by hydra.
+type ConsentRequest struct {
+	Challenge                    string                 `json:"challenge"`
+	Skip                         bool                   `json:"skip"`
+	Subject                      string                 `json:"subject"`
+	Client                       OAuth2Client           `json:"client"`
+	RequestURL                   string                 `json:"request_url"`
+	RequestedScope               []string               `json:"requested_scope"`
+	RequestedAccessTokenAudience []string               `json:"requested_access_token_audience"`
+	LoginChallenge               string                 `json:"login_challenge"`
+	LoginSessionID               string                 `json:"login_session_id"`
+	ACR                          string                 `json:"acr"`
+	AMR                          []string               `json:"amr"`
+	OIDCContext                  OIDCContext            `json:"oidc_context"`
+	Context                      map[string]interface{} `json:"context"`
+}
+
+// LogoutRequest is a logout request as returned by hydra.
+type LogoutRequest struct {
+	Challenge   string        `json:"challenge"`
+	Subject     string        `json:"subject"`
+	SessionID   string        `json:"sid"`
+	RequestURL  string        `json:"request_url"`
+	RPInitiated bool          `json:"rp_initiated"`
+	Client      *OAuth2Client `json:"client"`
+}
+
+// Error is an error response of hydra.
+type Error struct {
+	StatusCode       int    `json:"status_code"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	ErrorDebug       string `json:"error_debug,omitempty"`
+}
+
+// AcceptLoginBody is the body of a login accept request.
+type AcceptLoginBody struct {
+	Remember    bool     `json:"remember"`
+	RememberFor int      `json:"remember_for"`
+	Subject     string   `json:"subject"`
+	ACR         string   `json:"acr"`
+	AMR         []string `json:"amr"`
+}
+
+// AcceptConsentBody is the body of a consent accept request.
+type AcceptConsentBody struct {
+	Remember                 bool           `json:"remember"`
+	RememberFor              int            `json:"remember_for"`
+	GrantScope               []string       `json:"grant_scope"`
+	GrantAccessTokenAudience []string       `json:"grant_access_token_audience"`
+	Session                  ConsentSession `json:"session"`
+}
+
+// RejectBody is the body of a login or consent reject request.
+type RejectBody struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Call is a recorded accept or reject request.
+type Call struct {
+	// Flow is "login", "consent" or "logout".
+	Flow string
+	// Action is "accept" or "reject".
+	Action    string
+	Challenge string
+	Body      json.RawMessage
+	// RequestID is the X-Request-ID header sent by godra.
+	RequestID string
+}
+
+// Decode unmarshals the body of the call into v,
+// usually one of the *Body types of this package.
+func (c Call) Decode(v interface{}) error {
+	return json.Unmarshal(c.Body, v)
+}
+
+// Server is a fake hydra admin server.
+type Server struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests map[string]map[string]interface{}
+	errors   map[string][]Error
+	calls    []Call
+	// accepted or rejected requests, by flow and challenge
+	handled map[string]bool
+}
+
+// NewServer starts and returns a new fake hydra admin server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		requests: map[string]map[string]interface{}{
+			"login":   make(map[string]interface{}),
+			"consent": make(map[string]interface{}),
+			"logout":  make(map[string]interface{}),
+		},
+		errors:  make(map[string][]Error),
+		handled: make(map[string]bool),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddLoginRequest adds the given login request and returns its challenge.
+// A random challenge is generated if none is set.
+func (s *Server) AddLoginRequest(r LoginRequest) string {
+	if r.Challenge == "" {
+		r.Challenge = newChallenge()
+	}
+	s.add("login", r.Challenge, r)
+	return r.Challenge
+}
+
+// AddConsentRequest adds the given consent request and returns its challenge.
+// A random challenge is generated if none is set.
+func (s *Server) AddConsentRequest(r ConsentRequest) string {
+	if r.Challenge == "" {
+		r.Challenge = newChallenge()
+	}
+	s.add("consent", r.Challenge, r)
+	return r.Challenge
+}
+
+// AddLogoutRequest adds the given logout request and returns its challenge.
+// A random challenge is generated if none is set.
+func (s *Server) AddLogoutRequest(r LogoutRequest) string {
+	if r.Challenge == "" {
+		r.Challenge = newChallenge()
+	}
+	s.add("logout", r.Challenge, r)
+	return r.Challenge
+}
+
+func (s *Server) add(flow, challenge string, r interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests[flow][challenge] = r
+}
+
+// InjectError makes the next request of the given flow and action
+// fail with the given error. The action is "get", "accept" or "reject".
+// The readiness check is failed using the flow "health" and action "ready".
+// Multiple errors are returned in the order they were injected.
+func (s *Server) InjectError(flow, action string, e Error) {
+	if e.StatusCode == 0 {
+		e.StatusCode = http.StatusInternalServerError
+	}
+	if e.Error == "" {
+		e.Error = strings.ToLower(http.StatusText(e.StatusCode))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := flow + "/" + action
+	s.errors[key] = append(s.errors[key], e)
+}
+
+// Calls returns all recorded accept and reject requests.
+// Like hydra, each request can only be accepted or rejected once,
+// later attempts fail with a conflict and are not recorded.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := make([]Call, len(s.calls))
+	copy(calls, s.calls)
+	return calls
+}
+
+// LastCall returns the most recent accept or reject request
+// of the given flow and action.
+func (s *Server) LastCall(flow, action string) (Call, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.calls) - 1; i >= 0; i-- {
+		if s.calls[i].Flow == flow && s.calls[i].Action == action {
+			return s.calls[i], true
+		}
+	}
+	return Call{}, false
+}
+
+// RedirectURL returns the url the fake server redirects
+// the browser to after an accept or reject request.
+func (s *Server) RedirectURL(flow, action, challenge string) string {
+	params := url.Values{}
+	params.Add(fmt.Sprintf("%s_challenge", flow), challenge)
+	return fmt.Sprintf("%s/callback/%s/%s?%s", s.URL, flow, action, params.Encode())
+}
+
+// handle serves hydra's login, consent and logout request endpoints.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/health/ready" {
+		s.handleReady(w, r)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/oauth2/auth/requests/"), "/")
+	if !strings.HasPrefix(r.URL.Path, "/oauth2/auth/requests/") || len(parts) > 2 {
+		writeError(w, Error{StatusCode: http.StatusNotFound, Error: "not_found", ErrorDescription: "unknown path"})
+		return
+	}
+	flow := parts[0]
+	if _, ok := s.requests[flow]; !ok {
+		writeError(w, Error{StatusCode: http.StatusNotFound, Error: "not_found", ErrorDescription: "unknown flow"})
+		return
+	}
+	action := "get"
+	method := http.MethodGet
+	if len(parts) == 2 {
+		action = parts[1]
+		method = http.MethodPut
+		if action != "accept" && action != "reject" {
+			writeError(w, Error{StatusCode: http.StatusNotFound, Error: "not_found", ErrorDescription: "unknown action"})
+			return
+		}
+	}
+	if r.Method != method {
+		writeError(w, Error{StatusCode: http.StatusMethodNotAllowed, Error: "method_not_allowed"})
+		return
+	}
+	challenge := r.URL.Query().Get(fmt.Sprintf("%s_challenge", flow))
+	if challenge == "" {
+		writeError(w, Error{StatusCode: http.StatusBadRequest, Error: "invalid_request", ErrorDescription: "challenge is missing"})
+		return
+	}
+	var body []byte
+	if action != "get" {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, Error{StatusCode: http.StatusBadRequest, Error: "invalid_request", ErrorDescription: err.Error()})
+			return
+		}
+	}
+
+	s.mu.Lock()
+	key := flow + "/" + action
+	var injected *Error
+	if errs := s.errors[key]; len(errs) > 0 {
+		injected = &errs[0]
+		s.errors[key] = errs[1:]
+	}
+	req, ok := s.requests[flow][challenge]
+	handled := s.handled[flow+"/"+challenge]
+	if injected == nil && ok && !handled && action != "get" {
+		s.calls = append(s.calls, Call{Flow: flow, Action: action, Challenge: challenge, Body: body, RequestID: r.Header.Get("X-Request-ID")})
+		s.handled[flow+"/"+challenge] = true
+	}
+	s.mu.Unlock()
+
+	switch {
+	case injected != nil:
+		writeError(w, *injected)
+	case !ok:
+		writeError(w, Error{StatusCode: http.StatusNotFound, Error: "not_found", ErrorDescription: "Unable to locate the requested resource"})
+	case handled && action != "get":
+		writeError(w, Error{StatusCode: http.StatusConflict, Error: "conflict", ErrorDescription: "The request was already handled"})
+	case action == "get":
+		writeJSON(w, http.StatusOK, req)
+	default:
+		writeJSON(w, http.StatusOK, map[string]string{"redirect_to": s.RedirectURL(flow, action, challenge)})
+	}
+}
+
+// handleReady serves hydra's readiness check.
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	var injected *Error
+	if errs := s.errors["health/ready"]; len(errs) > 0 {
+		injected = &errs[0]
+		s.errors["health/ready"] = errs[1:]
+	}
+	s.mu.Unlock()
+	if injected != nil {
+		writeError(w, *injected)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, e Error) {
+	writeJSON(w, e.StatusCode, e)
+}
+
+// newChallenge returns a random challenge.
+func newChallenge() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("hydratest: unable to generate challenge: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,137 @@
+package hydratest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rbicker/godra/pkg/hydratest"
+)
+
+// send sends a request to the fake server and decodes the json response into v.
+func send(t *testing.T, method string, u string, body string, v interface{}) int {
+	t.Helper()
+	req, err := http.NewRequest(method, u, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if v != nil {
+		if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return res.StatusCode
+}
+
+// requestURL returns the url of the given flow and action.
+func requestURL(h *hydratest.Server, flow string, action string, challenge string) string {
+	path := "/oauth2/auth/requests/" + flow
+	if action != "get" {
+		path += "/" + action
+	}
+	return h.URL + path + "?" + url.Values{flow + "_challenge": {challenge}}.Encode()
+}
+
+func TestLoginRequest(t *testing.T) {
+	h := hydratest.NewServer()
+	defer h.Close()
+	want := hydratest.LoginRequest{
+		Client:         hydratest.OAuth2Client{ClientID: "app", RedirectURIs: []string{"https://app.example.com/callback"}},
+		RequestedScope: []string{"openid"},
+		OIDCContext:    hydratest.OIDCContext{LoginHint: "alice"},
+	}
+	challenge := h.AddLoginRequest(want)
+	want.Challenge = challenge
+
+	var got hydratest.LoginRequest
+	if code := send(t, http.MethodGet, requestURL(h, "login", "get", challenge), "", &got); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", code)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+
+	var redirect struct {
+		RedirectTo string `json:"redirect_to"`
+	}
+	if code := send(t, http.MethodPut, requestURL(h, "login", "accept", challenge), `{"subject": "alice", "remember": true}`, &redirect); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", code)
+	}
+	if redirect.RedirectTo != h.RedirectURL("login", "accept", challenge) {
+		t.Fatalf("unexpected redirect %v", redirect.RedirectTo)
+	}
+	call, ok := h.LastCall("login", "accept")
+	if !ok {
+		t.Fatal("expected the accept request to be recorded")
+	}
+	var accepted hydratest.AcceptLoginBody
+	if err := call.Decode(&accepted); err != nil {
+		t.Fatal(err)
+	}
+	if call.Challenge != challenge || accepted.Subject != "alice" || !accepted.Remember {
+		t.Fatalf("unexpected call %+v with body %+v", call, accepted)
+	}
+
+	// a request can only be handled once
+	var e hydratest.Error
+	if code := send(t, http.MethodPut, requestURL(h, "login", "reject", challenge), `{"error": "access_denied"}`, &e); code != http.StatusConflict {
+		t.Fatalf("expected status 409, got %v", code)
+	}
+	if len(h.Calls()) != 1 {
+		t.Fatalf("expected one recorded call, got %+v", h.Calls())
+	}
+}
+
+func TestConsentRequest(t *testing.T) {
+	h := hydratest.NewServer()
+	defer h.Close()
+	challenge := h.AddConsentRequest(hydratest.ConsentRequest{
+		Subject:        "alice",
+		Client:         hydratest.OAuth2Client{ClientID: "app"},
+		RequestedScope: []string{"openid", "profile"},
+	})
+	body := `{"grant_scope": ["openid"], "session": {"id_token": {"name": "Alice"}}}`
+	if code := send(t, http.MethodPut, requestURL(h, "consent", "accept", challenge), body, nil); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", code)
+	}
+	call, _ := h.LastCall("consent", "accept")
+	var granted hydratest.AcceptConsentBody
+	if err := call.Decode(&granted); err != nil {
+		t.Fatal(err)
+	}
+	want := hydratest.ConsentSession{IDToken: map[string]interface{}{"name": "Alice"}}
+	if !reflect.DeepEqual(granted.Session, want) || !reflect.DeepEqual(granted.GrantScope, []string{"openid"}) {
+		t.Fatalf("unexpected body %+v", granted)
+	}
+}
+
+func TestInjectError(t *testing.T) {
+	h := hydratest.NewServer()
+	defer h.Close()
+	challenge := h.AddLogoutRequest(hydratest.LogoutRequest{Subject: "alice", Client: &hydratest.OAuth2Client{ClientID: "app"}})
+	h.InjectError("logout", "get", hydratest.Error{StatusCode: http.StatusServiceUnavailable})
+
+	var e hydratest.Error
+	if code := send(t, http.MethodGet, requestURL(h, "logout", "get", challenge), "", &e); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %v", code)
+	}
+	if e.Error != "service unavailable" {
+		t.Fatalf("expected the error to be derived from the status, got %+v", e)
+	}
+	// the error is only returned once
+	var got hydratest.LogoutRequest
+	if code := send(t, http.MethodGet, requestURL(h, "logout", "get", challenge), "", &got); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", code)
+	}
+	if got.Subject != "alice" || got.Client == nil || got.Client.ClientID != "app" {
+		t.Fatalf("unexpected logout request %+v", got)
+	}
+}